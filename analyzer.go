@@ -5,6 +5,7 @@ import (
 	"go/ast"
 	"go/version"
 	"slices"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -18,25 +19,46 @@ func New(opts *Options) *analysis.Analyzer {
 		opts = &Options{NoMixedArguments: true}
 	}
 
-	return &analysis.Analyzer{
-		Name:     "sloglint",
-		Doc:      "Ensures consistent code style when using log/slog.",
-		URL:      "https://go-simpler.org/sloglint",
-		Flags:    flags(opts),
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+	analyzer := &analysis.Analyzer{
+		Name:      "sloglint",
+		Doc:       "Ensures consistent code style when using log/slog.",
+		URL:       "https://go-simpler.org/sloglint",
+		Requires:  []*analysis.Analyzer{inspect.Analyzer},
+		FactTypes: factTypes(opts),
 		Run: func(pass *analysis.Pass) (any, error) {
 			if err := opts.validate(); err != nil {
 				return nil, err
 			}
 
+			var keys []keyUsage
 			root := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector).Root()
 			for cursor := range root.Preorder(new(ast.CallExpr), new(ast.CompositeLit)) {
-				analyzeNode(pass, opts, cursor)
+				analyzeNode(pass, opts, cursor, &keys)
 			}
 
+			analyzePackage(pass, opts, keys)
 			return nil, nil
 		},
 	}
+
+	// The flags may enable the checks that need facts, so the fact types are updated when they are parsed.
+	analyzer.Flags = flags(opts, func() { analyzer.FactTypes = factTypes(opts) })
+
+	return analyzer
+}
+
+// factTypes returns the fact types needed for the enabled checks.
+// Declaring facts makes the driver analyze all the dependencies, including the standard library,
+// so they must only be declared if they're actually used.
+func factTypes(opts *Options) []analysis.Fact {
+	var facts []analysis.Fact
+	if opts.ConsistentKeyTypes {
+		facts = append(facts, new(keyTypesFact))
+	}
+	if opts.ConsistentKeyNames {
+		facts = append(facts, new(keyNamesFact))
+	}
+	return facts
 }
 
 var slogFuncs = []Func{
@@ -66,13 +88,29 @@ var slogFuncs = []Func{
 	{"(*log/slog.Logger).WarnContext", 1, 2},
 	{"(*log/slog.Logger).ErrorContext", 1, 2},
 	{"(*log/slog.Logger).With", -1, 0},
+	{"(*log/slog.Logger).WithGroup", -1, -1},
+}
+
+// keyUsage describes a single use of a log key.
+type keyUsage struct {
+	name   string   // Empty if the key is not a constant.
+	expr   ast.Expr // The key itself.
+	value  ast.Expr // May be nil, e.g. for groups.
+	groups []string // The names of the enclosing groups, outermost first.
+	group  bool     // Whether the key is a group name.
 }
 
-func analyzeNode(pass *analysis.Pass, opts *Options, cursor inspector.Cursor) {
+// path returns the name of the key qualified with the enclosing groups, e.g. "http.method",
+// since keys with the same name in different groups are different fields for log storages.
+func (u keyUsage) path() string {
+	return strings.Join(append(slices.Clone(u.groups), u.name), ".")
+}
+
+func analyzeNode(pass *analysis.Pass, opts *Options, cursor inspector.Cursor, keys *[]keyUsage) {
 	node := cursor.Node()
 
 	if cl, ok := node.(*ast.CompositeLit); ok && typeName(pass.TypesInfo, cl) == "log/slog.Attr" {
-		analyzeAttrKey(pass, opts, cl)
+		analyzeAttrKey(pass, opts, cl, enclosingGroups(pass.TypesInfo, cursor.Parent()), keys)
 		return
 	}
 
@@ -96,10 +134,19 @@ func analyzeNode(pass *analysis.Pass, opts *Options, cursor inspector.Cursor) {
 		"log/slog.Time",
 		"log/slog.Duration",
 		"log/slog.Any":
-		analyzeKey(pass, opts, call.Args[0])
+		groups := enclosingGroups(pass.TypesInfo, cursor.Parent())
+		analyzeKey(pass, opts, keyUsage{expr: call.Args[0], value: call.Args[1], groups: groups}, keys)
+		analyzeValue(pass, opts, call.Args[0], call.Args[1])
+		analyzeAttr(pass, opts, call)
 		return
-	case "log/slog.Group", "log/slog.GroupAttrs":
-		analyzeKey(pass, opts, call.Args[0])
+	case "log/slog.Group", "log/slog.GroupAttrs", "(*log/slog.Logger).WithGroup":
+		groups := enclosingGroups(pass.TypesInfo, cursor.Parent())
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && fn.Name() == "WithGroup" {
+			// The enclosing calls may be made on the logger returned by this call, so only the receiver's groups are considered.
+			groups = loggerGroups(pass.TypesInfo, outermostFunc(cursor), sel.X, call.Pos())
+		}
+		analyzeKey(pass, opts, keyUsage{expr: call.Args[0], groups: groups, group: true}, keys)
+		analyzeGroup(pass, opts, call, groups)
 		// Special case: don't return here, we also need to analyze the group's arguments.
 	}
 
@@ -118,7 +165,10 @@ func analyzeNode(pass *analysis.Pass, opts *Options, cursor inspector.Cursor) {
 		analyzeMessage(pass, opts, call.Args[pos])
 	}
 	if pos := funcs[idx].ArgumentsPos; pos >= 0 && len(call.Args) > pos {
-		analyzeArguments(pass, opts, call, call.Args[pos:])
+		analyzeArguments(pass, opts, call, cursor, call.Args[pos:], keys)
+	}
+	if f := funcs[idx]; f.MessagePos >= 0 && f.ArgumentsPos >= 0 {
+		analyzeLogCall(pass, opts, call, cursor, call.Args[min(f.ArgumentsPos, len(call.Args)):])
 	}
 }
 
@@ -129,6 +179,9 @@ func analyzeFunction(pass *analysis.Pass, opts *Options, call *ast.CallExpr, cur
 	if opts.ContextOnly != "" {
 		contextOnly(pass, call, cursor, opts.ContextOnly == contextOnlyScope)
 	}
+	if opts.NamedLevels {
+		namedLevel(pass, call, opts.CustomLevels)
+	}
 	v := pass.Module.GoVersion // Empty in test runs.
 	if v == "" || version.Compare("go"+v, "go1.24") >= 0 {
 		discardHandler(pass, call)
@@ -144,8 +197,9 @@ func analyzeMessage(pass *analysis.Pass, opts *Options, msg ast.Expr) {
 	}
 }
 
-func analyzeArguments(pass *analysis.Pass, opts *Options, call *ast.CallExpr, args []ast.Expr) {
+func analyzeArguments(pass *analysis.Pass, opts *Options, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr, usages *[]keyUsage) {
 	var keys, attrs []ast.Expr
+	groups := enclosingGroups(pass.TypesInfo, cursor)
 
 	for i := 0; i < len(args); i++ {
 		typ := pass.TypesInfo.TypeOf(args[i])
@@ -155,7 +209,14 @@ func analyzeArguments(pass *analysis.Pass, opts *Options, call *ast.CallExpr, ar
 		switch typ.String() {
 		case "string":
 			keys = append(keys, args[i])
-			analyzeKey(pass, opts, args[i])
+			var value ast.Expr
+			if i+1 < len(args) {
+				value = args[i+1]
+			}
+			analyzeKey(pass, opts, keyUsage{expr: args[i], value: value, groups: groups}, usages)
+			if value != nil {
+				analyzeValue(pass, opts, args[i], value)
+			}
 			i++ // Skip the value.
 		case "log/slog.Attr":
 			attrs = append(attrs, args[i])
@@ -176,36 +237,166 @@ func analyzeArguments(pass *analysis.Pass, opts *Options, call *ast.CallExpr, ar
 	if opts.ArgumentsOnSeparateLines {
 		argumentsOnSeparateLines(pass, keys, attrs)
 	}
+	if opts.MaxArguments > 0 {
+		maxArguments(pass, call, keys, attrs, opts.MaxArguments)
+	}
+	if opts.ErrorKey != "" {
+		errorKey(pass, args, opts.ErrorKey, opts.KeyPackages)
+	}
+	if opts.ArgumentOrder != "" || opts.GroupsLast {
+		argumentOrder(pass, call, args, opts.ArgumentOrder, opts.ArgumentOrderSchema, opts.GroupsLast)
+	}
+}
+
+// analyzeLogCall analyzes a call that writes a log record, unlike e.g. With or Group.
+// The arguments may be empty.
+func analyzeLogCall(pass *analysis.Pass, opts *Options, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr) {
+	if len(opts.RequiredKeys) > 0 {
+		requiredKeys(pass, call, cursor, args, opts.RequiredKeys)
+	}
+	if opts.MaxAttributes > 0 {
+		maxAttributes(pass, call, cursor, args, opts.MaxAttributes)
+	}
+	if opts.ErrorKey != "" {
+		requiredErrorKey(pass, call, cursor, args, opts.ErrorKey)
+	}
+	if len(opts.AllowedLevels) > 0 {
+		allowedLevels(pass, call, opts.AllowedLevels)
+	}
+	if opts.GuardedDebugArguments {
+		guardedDebugArgs(pass, call, cursor, args)
+	}
+	if opts.NoLogAndReturn {
+		noLogAndReturn(pass, call, cursor, args, opts.LogAndReturnExceptions)
+	}
+	if opts.ErrorLevel != "" {
+		blocks := opts.ErrorBlocks
+		if len(blocks) == 0 {
+			blocks = []string{errorBlockIf}
+		}
+		errorLevel(pass, call, cursor, args, opts.ErrorLevel, blocks)
+	}
 }
 
-func analyzeKey(pass *analysis.Pass, opts *Options, key ast.Expr) {
+func analyzeKey(pass *analysis.Pass, opts *Options, usage keyUsage, keys *[]keyUsage) {
+	key := usage.expr
+	if name, ok := constKeyName(pass.TypesInfo, key); ok {
+		usage.name = name
+		*keys = append(*keys, usage)
+	}
+
+	allowed := opts.AllowedKeys
+	if n := len(usage.groups); n > 0 {
+		if groupAllowed, ok := opts.GroupAllowedKeys[usage.groups[n-1]]; ok {
+			allowed = groupAllowed
+		}
+	}
+	if usage.group && len(opts.AllowedGroups) > 0 {
+		allowed = nil // Group names are checked by allowedGroups instead.
+	}
+	namingCase := opts.KeyNamingCase
+	if usage.group && opts.GroupNamingCase != "" {
+		namingCase = "" // Group names are checked by groupNamingCase instead.
+	}
+
 	if opts.ConstantKeys {
 		constantKeys(pass, key)
 	}
-	if opts.KeyNamingCase != "" {
-		keyNamingCase(pass, key, opts.KeyNamingCase)
+	if namingCase != "" {
+		keyNamingCase(pass, key, opts.KeyNamingCase, opts.KeyNamingInitialisms, opts.KeyNamingSegments, opts.KeyNamingExceptions)
+	}
+	if opts.SafeKeys {
+		var caseFn func(string) string
+		if opts.KeyNamingCase != "" {
+			caseFn = keyCaseFunc(opts.KeyNamingCase, opts.KeyNamingInitialisms, opts.KeyNamingSegments)
+		}
+		safeKeys(pass, key, caseFn)
+	}
+	if opts.KeyNamingPattern != "" {
+		keyNamingPattern(pass, key, opts.KeyNamingPattern, opts.KeyNamingExceptions)
 	}
-	if len(opts.AllowedKeys) > 0 {
-		allowedKeys(pass, key, opts.AllowedKeys)
+	if len(allowed) > 0 {
+		allowedKeys(pass, key, allowed, opts.KeyPackages)
 	}
 	if len(opts.ForbiddenKeys) > 0 {
 		forbiddenKeys(pass, key, opts.ForbiddenKeys)
 	}
+	if len(opts.KeyNamespaces) > 0 {
+		keyNamespace(pass, key, usage.groups, opts.KeyNamespaces)
+	}
+	if len(opts.KeyPresets) > 0 {
+		keyPresets(pass, key, opts.KeyPresets)
+	}
+	if len(opts.RenamedKeys) > 0 {
+		renamedKeys(pass, key, opts.RenamedKeys, opts.KeyPackages)
+	}
+}
+
+func analyzeGroup(pass *analysis.Pass, opts *Options, call *ast.CallExpr, groups []string) {
+	if opts.GroupNamingCase != "" {
+		groupNamingCase(pass, call.Args[0], opts.GroupNamingCase, opts.KeyNamingInitialisms)
+	}
+	if len(opts.AllowedGroups) > 0 {
+		allowedGroups(pass, call.Args[0], opts.AllowedGroups)
+	}
+	if len(opts.ForbiddenGroups) > 0 {
+		forbiddenGroups(pass, call.Args[0], opts.ForbiddenGroups)
+	}
+	if opts.MaxGroupDepth > 0 {
+		maxGroupDepth(pass, call, len(groups)+1, opts.MaxGroupDepth)
+	}
+	if opts.NoEmptyGroups {
+		noEmptyGroups(pass, call)
+	}
 }
 
-func analyzeAttrKey(pass *analysis.Pass, opts *Options, attr *ast.CompositeLit) {
+// analyzeValue analyzes the value of a key-value pair or an attribute created with a constructor, e.g. slog.Any.
+func analyzeValue(pass *analysis.Pass, opts *Options, key, value ast.Expr) {
+	if opts.LogValuerReceivers {
+		logValuerReceiver(pass, value)
+	}
+	if len(opts.LogValuerTypes) > 0 || opts.SensitiveTypes {
+		requiredLogValuer(pass, value, opts.LogValuerTypes, opts.SensitiveTypes)
+	}
+	if len(opts.ForbiddenValueTypes) > 0 {
+		forbiddenValueTypes(pass, value, opts.ForbiddenValueTypes)
+	}
+	if len(opts.SensitiveValues) > 0 || len(opts.SensitiveKeys) > 0 {
+		sensitiveValues(pass, key, value, opts.SensitiveValues, opts.SensitiveKeys, opts.RedactionFuncs)
+	}
+}
+
+func analyzeAttr(pass *analysis.Pass, opts *Options, call *ast.CallExpr) {
+	if opts.TypedAttributes {
+		typedAttrs(pass, call)
+	}
+}
+
+func analyzeAttrKey(pass *analysis.Pass, opts *Options, attr *ast.CompositeLit, groups []string, keys *[]keyUsage) {
 	switch len(attr.Elts) {
 	case 1:
 		if kv := attr.Elts[0].(*ast.KeyValueExpr); kv.Key.(*ast.Ident).Name == "Key" {
-			analyzeKey(pass, opts, kv.Value) // slog.Attr{Key: ...}
+			analyzeKey(pass, opts, keyUsage{expr: kv.Value, groups: groups}, keys) // slog.Attr{Key: ...}
 		}
 	case 2:
 		if kv, ok := attr.Elts[0].(*ast.KeyValueExpr); ok && kv.Key.(*ast.Ident).Name == "Key" {
-			analyzeKey(pass, opts, kv.Value) // slog.Attr{Key: ..., Value: ...}
+			analyzeKey(pass, opts, keyUsage{expr: kv.Value, groups: groups}, keys) // slog.Attr{Key: ..., Value: ...}
 		} else if kv, ok := attr.Elts[1].(*ast.KeyValueExpr); ok && kv.Key.(*ast.Ident).Name == "Key" {
-			analyzeKey(pass, opts, kv.Value) // slog.Attr{Value: ..., Key: ...}
+			analyzeKey(pass, opts, keyUsage{expr: kv.Value, groups: groups}, keys) // slog.Attr{Value: ..., Key: ...}
 		} else {
-			analyzeKey(pass, opts, attr.Elts[0]) // slog.Attr{..., ...}
+			analyzeKey(pass, opts, keyUsage{expr: attr.Elts[0], groups: groups}, keys) // slog.Attr{..., ...}
 		}
 	}
 }
+
+func analyzePackage(pass *analysis.Pass, opts *Options, keys []keyUsage) {
+	if opts.ConstantKeys {
+		rawKeys(pass, keys, opts.KeyPackages)
+	}
+	if opts.ConsistentKeyTypes {
+		consistentKeyTypes(pass, keys)
+	}
+	if opts.ConsistentKeyNames {
+		consistentKeyNames(pass, keys)
+	}
+}
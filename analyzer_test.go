@@ -11,26 +11,65 @@ func TestAnalyzer(t *testing.T) {
 		{FullName: "no_mixed_args.customLog", MessagePos: 0, ArgumentsPos: 1},
 	}
 
+	errorLevelFuncs := []Func{
+		{FullName: "error_level.logDebug", MessagePos: 0, ArgumentsPos: 1},
+		{FullName: "error_level.logErrorInfo", MessagePos: 0, ArgumentsPos: 1},
+		{FullName: "error_level.logInformation", MessagePos: 0, ArgumentsPos: 1},
+	}
+
 	tests := map[string]struct {
 		dir  string
 		opts Options
 	}{
-		"no global logger (all)":      {dir: "no_global_all", opts: Options{NoGlobalLogger: noGlobalLoggerAll}},
-		"no global logger (default)":  {dir: "no_global_default", opts: Options{NoGlobalLogger: noGlobalLoggerDefault}},
-		"context only (all)":          {dir: "context_only_all", opts: Options{ContextOnly: contextOnlyAll}},
-		"context only (scope)":        {dir: "context_only_scope", opts: Options{ContextOnly: contextOnlyScope}},
-		"discard handler":             {dir: "discard_handler", opts: Options{}},
-		"static message":              {dir: "static_msg", opts: Options{StaticMessage: true}},
-		"message style (lowercased)":  {dir: "msg_style_lowercased", opts: Options{MessageStyle: messageStyleLowercased}},
-		"message style (capitalized)": {dir: "msg_style_capitalized", opts: Options{MessageStyle: messageStyleCapitalized}},
-		"no mixed arguments":          {dir: "no_mixed_args", opts: Options{NoMixedArguments: true, CustomFuncs: custom}},
-		"key-value pairs only":        {dir: "kv_only", opts: Options{KeyValuePairsOnly: true}},
-		"attributes only":             {dir: "attr_only", opts: Options{AttributesOnly: true}},
-		"arguments on separate lines": {dir: "args_on_sep_lines", opts: Options{ArgumentsOnSeparateLines: true}},
-		"constant keys":               {dir: "no_raw_keys", opts: Options{ConstantKeys: true}},
-		"allowed keys":                {dir: "allowed_keys", opts: Options{AllowedKeys: []string{"foo"}}},
-		"forbidden keys":              {dir: "forbidden_keys", opts: Options{ForbiddenKeys: []string{"bar"}}},
-		"key naming case":             {dir: "key_naming_case", opts: Options{KeyNamingCase: keyNamingCaseSnake}},
+		"no global logger (all)":        {dir: "no_global_all", opts: Options{NoGlobalLogger: noGlobalLoggerAll}},
+		"no global logger (default)":    {dir: "no_global_default", opts: Options{NoGlobalLogger: noGlobalLoggerDefault}},
+		"context only (all)":            {dir: "context_only_all", opts: Options{ContextOnly: contextOnlyAll}},
+		"context only (scope)":          {dir: "context_only_scope", opts: Options{ContextOnly: contextOnlyScope}},
+		"discard handler":               {dir: "discard_handler", opts: Options{}},
+		"static message":                {dir: "static_msg", opts: Options{StaticMessage: true}},
+		"message style (lowercased)":    {dir: "msg_style_lowercased", opts: Options{MessageStyle: messageStyleLowercased}},
+		"message style (capitalized)":   {dir: "msg_style_capitalized", opts: Options{MessageStyle: messageStyleCapitalized}},
+		"no mixed arguments":            {dir: "no_mixed_args", opts: Options{NoMixedArguments: true, CustomFuncs: custom}},
+		"key-value pairs only":          {dir: "kv_only", opts: Options{KeyValuePairsOnly: true}},
+		"attributes only":               {dir: "attr_only", opts: Options{AttributesOnly: true}},
+		"arguments on separate lines":   {dir: "args_on_sep_lines", opts: Options{ArgumentsOnSeparateLines: true}},
+		"max arguments":                 {dir: "max_args", opts: Options{MaxArguments: 2, MaxAttributes: 3}},
+		"LogValuer receivers":           {dir: "log_valuer_receivers", opts: Options{LogValuerReceivers: true}},
+		"LogValuer types":               {dir: "log_valuer_types", opts: Options{LogValuerTypes: []string{"log_valuer_types/models.*"}, SensitiveTypes: true}},
+		"sensitive values":              {dir: "sensitive_values", opts: Options{SensitiveValues: []string{"password", "apiKey", "r.Header"}, SensitiveKeys: []string{"secret", "*_token"}, RedactionFuncs: []string{"sensitive_values.redact"}}},
+		"forbidden value types":         {dir: "forbidden_value_types", opts: Options{ForbiddenValueTypes: map[string]string{"context.Context": "", "*net/http.Request": "r.URL.Path", "*database/sql.DB": "", "[]byte": "string(b)", "chan": "", "func": "", "*log/slog.Logger": ""}}},
+		"typed attributes":              {dir: "typed_attrs", opts: Options{TypedAttributes: true}},
+		"error key":                     {dir: "error_key", opts: Options{ErrorKey: "err"}},
+		"guarded debug arguments":       {dir: "guarded_debug_args", opts: Options{GuardedDebugArguments: true}},
+		"no log and return":             {dir: "no_log_and_return", opts: Options{NoLogAndReturn: true, LogAndReturnExceptions: []string{"(*no_log_and_return.Server).handle*"}}},
+		"named levels":                  {dir: "named_levels", opts: Options{NamedLevels: true, CustomLevels: []string{"named_levels.LevelTrace"}}},
+		"allowed levels":                {dir: "allowed_levels/...", opts: Options{AllowedLevels: map[string][]string{"allowed_levels/lib": {levelDebug, levelInfo, levelWarn}}}},
+		"error level":                   {dir: "error_level", opts: Options{ErrorLevel: levelWarn, ErrorBlocks: []string{errorBlockIf, errorBlockElse, errorBlockCase}, CustomFuncs: errorLevelFuncs}},
+		"argument order (alphabetical)": {dir: "arg_order_alphabetical", opts: Options{ArgumentOrder: argumentOrderAlphabetical}},
+		"argument order (schema)":       {dir: "arg_order_schema", opts: Options{ArgumentOrder: argumentOrderSchema, ArgumentOrderSchema: []string{"request_id", "http.*", "*", "error"}, GroupsLast: true}},
+		"constant keys":                 {dir: "no_raw_keys", opts: Options{ConstantKeys: true, KeyPackages: []string{"no_raw_keys/keys"}}},
+		"allowed keys":                  {dir: "allowed_keys", opts: Options{AllowedKeys: []string{"foo", "user_id", "@timestamp"}, KeyPackages: []string{"allowed_keys/keys"}}},
+		"allowed keys (patterns)":       {dir: "allowed_keys_patterns", opts: Options{AllowedKeys: []string{"user_id", "http", "user", "http.*", "^x_"}, GroupAllowedKeys: map[string][]string{"http": {"method", "status", "user"}}}},
+		"forbidden keys":                {dir: "forbidden_keys", opts: Options{ForbiddenKeys: []string{"bar", "*_secret", "^pass", "^secret_"}}},
+		"renamed keys":                  {dir: "renamed_keys", opts: Options{RenamedKeys: map[string]string{"uid": "user_id"}, KeyPackages: []string{"renamed_keys/keys"}}},
+		"key naming case":               {dir: "key_naming_case", opts: Options{KeyNamingCase: keyNamingCaseSnake}},
+		"key naming case (camel)":       {dir: "key_naming_case_camel", opts: Options{KeyNamingCase: keyNamingCaseCamel, KeyNamingInitialisms: []string{"ID", "HTTP"}, KeyNamingExceptions: []string{"legacy_*"}}},
+		"key naming case (dot)":         {dir: "key_naming_case_dot", opts: Options{KeyNamingCase: keyNamingCaseDot}},
+		"key naming case (screaming)":   {dir: "key_naming_case_screaming_snake", opts: Options{KeyNamingCase: keyNamingCaseScreamingSnake}},
+		"key naming case (segments)":    {dir: "key_naming_case_segments", opts: Options{KeyNamingCase: keyNamingCaseSnake, KeyNamingSegments: true}},
+		"key naming pattern":            {dir: "key_naming_pattern", opts: Options{KeyNamingPattern: `^[a-z.]+$`, KeyNamingExceptions: []string{"X-Request-ID"}}},
+		"key namespaces":                {dir: "key_namespaces/...", opts: Options{KeyNamespaces: map[string]string{"key_namespaces/billing/...": "billing"}}},
+		"required keys":                 {dir: "required_keys/...", opts: Options{RequiredKeys: []KeyRequirement{{Keys: []string{"op"}, Funcs: []string{"(*required_keys.Server).Handle*"}}, {Keys: []string{"request_id"}, HTTPHandlers: true}, {Keys: []string{"error"}, Levels: []string{levelError}}, {Keys: []string{"tenant_id"}, Packages: []string{"required_keys/billing"}}}}},
+		"key presets":                   {dir: "key_presets", opts: Options{KeyPresets: []string{keyPresetOTel, keyPresetECS}}},
+		"allowed keys (presets)":        {dir: "allowed_keys_presets", opts: Options{AllowedKeys: []string{"@otel", "foo"}}},
+		"safe keys":                     {dir: "safe_keys", opts: Options{SafeKeys: true}},
+		"safe keys (fix)":               {dir: "safe_keys_fix", opts: Options{SafeKeys: true, KeyNamingCase: keyNamingCaseSnake}},
+		"consistent key types":          {dir: "key_types", opts: Options{ConsistentKeyTypes: true}},
+		"consistent key names":          {dir: "key_names", opts: Options{ConsistentKeyNames: true}},
+		"group naming case":             {dir: "group_naming_case", opts: Options{GroupNamingCase: keyNamingCaseSnake, KeyNamingCase: keyNamingCaseCamel, KeyNamingInitialisms: []string{"ID"}}},
+		"allowed groups":                {dir: "allowed_groups", opts: Options{AllowedGroups: []string{"http", "user_*", "internal"}, ForbiddenGroups: []string{"internal"}, AllowedKeys: []string{"user_id"}}},
+		"max group depth":               {dir: "max_group_depth", opts: Options{MaxGroupDepth: 2}},
+		"no empty groups":               {dir: "no_empty_groups", opts: Options{NoEmptyGroups: true}},
 	}
 
 	for name, test := range tests {
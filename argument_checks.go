@@ -1,10 +1,17 @@
 package sloglint
 
 import (
+	"cmp"
+	"fmt"
 	"go/ast"
+	"go/token"
+	"go/types"
 	"slices"
+	"strconv"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
 	"golang.org/x/tools/go/types/typeutil"
 )
 
@@ -76,3 +83,554 @@ func argumentsOnSeparateLines(pass *analysis.Pass, keys, attrs []ast.Expr) {
 		prevLine = currLine
 	}
 }
+
+func maxArguments(pass *analysis.Pass, call *ast.CallExpr, keys, attrs []ast.Expr, limit int) {
+	if n := len(keys) + len(attrs); n > limit {
+		name := shortFuncName(pass.TypesInfo, call)
+		if name == "" {
+			name = "the call"
+		}
+		pass.ReportRangef(call, "%s has %d arguments, which is more than %d", name, n, limit)
+	}
+}
+
+func maxAttributes(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr, limit int) {
+	n := countAttributes(pass.TypesInfo, args)
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		n += countAttributes(pass.TypesInfo, loggerArgs(pass.TypesInfo, outermostFunc(cursor), sel.X, call.Pos()))
+	}
+	if n > limit {
+		pass.ReportRangef(call, "the log call has %d attributes, which is more than %d", n, limit)
+	}
+}
+
+// countAttributes returns the number of attributes the arguments result in, including the ones nested in groups.
+// Unpacked slices are not counted.
+func countAttributes(info *types.Info, args []ast.Expr) int {
+	var n int
+	for i := 0; i < len(args); i++ {
+		switch typeName(info, args[i]) {
+		case "string":
+			n++
+			i++ // Skip the value.
+		case "log/slog.Attr":
+			if call, ok := args[i].(*ast.CallExpr); ok && isGroup(info, call) && len(call.Args) > 0 {
+				n += countAttributes(info, call.Args[1:])
+				continue
+			}
+			n++
+		}
+	}
+	return n
+}
+
+func requiredKeys(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr, reqs []KeyRequirement) {
+	if call.Ellipsis.IsValid() {
+		return // The keys of an unpacked slice are unknown.
+	}
+
+	var required []string
+	for _, req := range reqs {
+		if matchRequirement(pass, call, cursor, req) {
+			required = append(required, req.Keys...)
+		}
+	}
+	if len(required) == 0 {
+		return
+	}
+
+	present := argumentKeys(pass.TypesInfo, args)
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		present = append(present, argumentKeys(pass.TypesInfo, loggerArgs(pass.TypesInfo, outermostFunc(cursor), sel.X, call.Pos()))...)
+	}
+
+	var missing []string
+	for _, key := range required {
+		if quoted := strconv.Quote(key); !slices.Contains(present, key) && !slices.Contains(missing, quoted) {
+			missing = append(missing, quoted)
+		}
+	}
+
+	switch len(missing) {
+	case 0:
+	case 1:
+		pass.ReportRangef(call, "the log call should include the %s key", missing[0])
+	default:
+		pass.ReportRangef(call, "the log call should include the %s keys", strings.Join(missing, ", "))
+	}
+}
+
+// matchRequirement reports whether the log call matches the packages, functions, and levels of the requirement.
+func matchRequirement(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor, req KeyRequirement) bool {
+	if len(req.Packages) > 0 && !slices.ContainsFunc(req.Packages, func(pattern string) bool {
+		return matchPackage(pattern, pass.Pkg.Path())
+	}) {
+		return false
+	}
+
+	if len(req.Levels) > 0 {
+		level, ok := logLevel(pass.TypesInfo, call)
+		if !ok || !slices.Contains(req.Levels, levelName(level)) {
+			return false
+		}
+	}
+
+	if len(req.Funcs) == 0 && !req.HTTPHandlers {
+		return true
+	}
+
+	for cursor := range cursor.Enclosing(new(ast.FuncDecl), new(ast.FuncLit)) {
+		var params *ast.FieldList
+		switch fn := cursor.Node().(type) {
+		case *ast.FuncDecl:
+			params = fn.Type.Params
+			if obj := pass.TypesInfo.Defs[fn.Name]; obj != nil && slices.ContainsFunc(req.Funcs, func(pattern string) bool {
+				return cachedRegexp(keyPatternRegexp(pattern)).MatchString(obj.(*types.Func).FullName())
+			}) {
+				return true
+			}
+		case *ast.FuncLit:
+			params = fn.Type.Params
+		}
+		if req.HTTPHandlers && slices.ContainsFunc(params.List, func(param *ast.Field) bool {
+			return typeName(pass.TypesInfo, param.Type) == "*net/http.Request"
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loggerArgs returns the arguments added to the logger with With calls, see [loggerCalls].
+func loggerArgs(info *types.Info, fn ast.Node, logger ast.Expr, before token.Pos) []ast.Expr {
+	var args []ast.Expr
+	for _, call := range loggerCalls(info, fn, logger, before) {
+		if funcName(info, call) != "(*log/slog.Logger).WithGroup" {
+			args = append(args, call.Args...)
+		}
+	}
+	return args
+}
+
+// loggerGroups returns the names of the groups opened on the logger with WithGroup calls, outermost first, see [loggerCalls].
+func loggerGroups(info *types.Info, fn ast.Node, logger ast.Expr, before token.Pos) []string {
+	var groups []string
+	for _, call := range loggerCalls(info, fn, logger, before) {
+		if funcName(info, call) == "(*log/slog.Logger).WithGroup" && len(call.Args) == 1 {
+			name, _ := constKeyName(info, call.Args[0])
+			groups = append(groups, name)
+		}
+	}
+	return groups
+}
+
+// loggerCalls returns the With and WithGroup calls the logger is derived from, in the order they're applied,
+// either chained or assigned earlier in the function.
+// Only the assignments before the given position are considered, the last one of them is used.
+func loggerCalls(info *types.Info, fn ast.Node, logger ast.Expr, before token.Pos) []*ast.CallExpr {
+	switch logger := ast.Unparen(logger).(type) {
+	case *ast.CallExpr:
+		switch funcName(info, logger) {
+		case "log/slog.With":
+			return []*ast.CallExpr{logger}
+		case "(*log/slog.Logger).With", "(*log/slog.Logger).WithGroup":
+			var calls []*ast.CallExpr
+			if sel, ok := logger.Fun.(*ast.SelectorExpr); ok {
+				calls = loggerCalls(info, fn, sel.X, before)
+			}
+			return append(calls, logger)
+		}
+	case *ast.Ident:
+		obj := info.ObjectOf(logger)
+		if obj == nil || fn == nil {
+			return nil
+		}
+		var calls []*ast.CallExpr
+		ast.Inspect(fn, func(node ast.Node) bool {
+			if node == nil || node.Pos() >= before {
+				return false
+			}
+			var lhs, rhs []ast.Expr
+			switch node := node.(type) {
+			case *ast.AssignStmt:
+				lhs, rhs = node.Lhs, node.Rhs
+			case *ast.ValueSpec:
+				for _, name := range node.Names {
+					lhs = append(lhs, name)
+				}
+				rhs = node.Values
+			default:
+				return true
+			}
+			if len(lhs) != len(rhs) {
+				return true
+			}
+			for i := range lhs {
+				if ident, ok := lhs[i].(*ast.Ident); ok && info.ObjectOf(ident) == obj {
+					calls = loggerCalls(info, fn, rhs[i], node.Pos()) // The last assignment wins.
+				}
+			}
+			return true
+		})
+		return calls
+	}
+	return nil
+}
+
+// outermostFunc returns the outermost function declaration or literal that encloses the cursor, or nil.
+func outermostFunc(cursor inspector.Cursor) ast.Node {
+	var fn ast.Node
+	for cursor := range cursor.Enclosing(new(ast.FuncDecl), new(ast.FuncLit)) {
+		fn = cursor.Node()
+	}
+	return fn
+}
+
+// argument is a single log argument, either a key-value pair or an attribute.
+type argument struct {
+	name     string
+	group    bool
+	pos, end token.Pos
+}
+
+func argumentOrder(pass *analysis.Pass, call *ast.CallExpr, args []ast.Expr, order string, schema []string, groupsLast bool) {
+	var arguments []argument
+	for i := 0; i < len(args); i++ {
+		var key ast.Expr
+		pos, end := args[i].Pos(), args[i].End()
+		switch typeName(pass.TypesInfo, args[i]) {
+		case "string":
+			if i+1 == len(args) {
+				return
+			}
+			key = args[i]
+			end = args[i+1].End()
+			i++ // Skip the value.
+		case "log/slog.Attr":
+			switch arg := args[i].(type) {
+			case *ast.CallExpr:
+				if strings.HasPrefix(funcName(pass.TypesInfo, arg), "log/slog.") && len(arg.Args) > 0 {
+					key = arg.Args[0]
+				}
+			case *ast.CompositeLit:
+				key = attrLiteralKey(arg)
+			}
+		}
+		if key == nil {
+			return // E.g. an attribute stored in a variable or an unpacked slice.
+		}
+		name, ok := constKeyName(pass.TypesInfo, key)
+		if !ok {
+			return
+		}
+		arguments = append(arguments, argument{name: name, group: isGroup(pass.TypesInfo, args[i]), pos: pos, end: end})
+	}
+
+	rank := func(name string) int {
+		if idx := slices.Index(schema, name); idx >= 0 {
+			return idx
+		}
+		if idx := slices.IndexFunc(schema, func(pattern string) bool {
+			return isKeyPattern(pattern) && matchKeys([]string{pattern}, name)
+		}); idx >= 0 {
+			return idx
+		}
+		return len(schema)
+	}
+
+	sorted := slices.Clone(arguments)
+	slices.SortStableFunc(sorted, func(a, b argument) int {
+		if groupsLast && a.group != b.group {
+			if a.group {
+				return 1
+			}
+			return -1
+		}
+		switch order {
+		case argumentOrderAlphabetical:
+			return strings.Compare(a.name, b.name)
+		case argumentOrderSchema:
+			return cmp.Compare(rank(a.name), rank(b.name))
+		}
+		return 0
+	})
+
+	idx := -1
+	for i := range arguments {
+		if arguments[i] != sorted[i] {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     arguments[idx].pos,
+		End:     arguments[idx].end,
+		Message: fmt.Sprintf("the %q argument should go before %q", sorted[idx].name, arguments[idx].name),
+	}
+
+	var edits []analysis.TextEdit
+	for i := range arguments {
+		if arguments[i] == sorted[i] {
+			continue
+		}
+		text, ok := sourceText(pass, sorted[i].pos, sorted[i].end)
+		if !ok {
+			edits = nil
+			break
+		}
+		edits = append(edits, analysis.TextEdit{Pos: arguments[i].pos, End: arguments[i].end, NewText: text})
+	}
+	if len(edits) > 0 {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{TextEdits: edits}}
+	}
+
+	pass.Report(diag)
+}
+
+func errorKey(pass *analysis.Pass, args []ast.Expr, errKey string, keyPkgs []string) {
+	for i := 0; i < len(args); i++ {
+		switch typeName(pass.TypesInfo, args[i]) {
+		case "string":
+			if i+1 < len(args) {
+				errorValue(pass, nil, args[i], args[i+1], errKey, keyPkgs)
+			}
+			i++ // Skip the value.
+		case "log/slog.Attr":
+			call, ok := args[i].(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 {
+				continue
+			}
+			if name := funcName(pass.TypesInfo, call); name == "log/slog.String" || name == "log/slog.Any" {
+				errorValue(pass, call, call.Args[0], call.Args[1], errKey, keyPkgs)
+			}
+		}
+	}
+}
+
+// errorValue checks a single key-value pair, or a slog.String/Any call if attr is not nil.
+func errorValue(pass *analysis.Pass, attr *ast.CallExpr, key, value ast.Expr, errKey string, keyPkgs []string) {
+	if err, ok := errorString(pass.TypesInfo, value); ok {
+		text, ok := sourceText(pass, err.Pos(), err.End())
+		if !ok {
+			return
+		}
+		diag := analysis.Diagnostic{
+			Pos:     value.Pos(),
+			End:     value.End(),
+			Message: "the error should be logged as is, not as a string",
+		}
+		edits := []analysis.TextEdit{{Pos: value.Pos(), End: value.End(), NewText: text}}
+		if lit, ok := key.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			edits = append(edits, analysis.TextEdit{Pos: lit.Pos(), End: lit.End(), NewText: strconv.AppendQuote(nil, errKey)})
+		}
+		if attr != nil {
+			sel, ok := attr.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return
+			}
+			edits = append(edits, analysis.TextEdit{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: []byte("Any")})
+		}
+		diag.SuggestedFixes = []analysis.SuggestedFix{{TextEdits: edits}}
+		pass.Report(diag)
+		return
+	}
+
+	if !isError(pass.TypesInfo, value) {
+		return
+	}
+
+	name, ok := constKeyName(pass.TypesInfo, key)
+	if !ok || name == errKey {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     key.Pos(),
+		End:     key.End(),
+		Message: fmt.Sprintf("the error should be logged under the %q key", errKey),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			TextEdits: []analysis.TextEdit{{Pos: key.Pos(), End: key.End(), NewText: []byte(keyReplacement(pass, key, errKey, keyPkgs))}},
+		}},
+	}
+	pass.Report(diag)
+}
+
+// keyReplacement returns the text to replace the key with at the call site.
+// Constant keys are replaced with a constant that has the new value, if there is one, otherwise with a string literal;
+// their declarations are never changed, since the constants may be used elsewhere, even outside of logging.
+func keyReplacement(pass *analysis.Pass, key ast.Expr, newKey string, keyPkgs []string) string {
+	if !isStringLiteral(key) {
+		if constName, ok := stringConstant(pass.Pkg.Scope(), newKey, false); ok && visibleAt(pass, constName, key.Pos()) {
+			return constName
+		}
+		if constName, ok := keyConstant(pass, key.Pos(), keyPkgs, newKey); ok {
+			return constName
+		}
+	}
+	return strconv.Quote(newKey)
+}
+
+func requiredErrorKey(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr, errKey string) {
+	if call.Ellipsis.IsValid() {
+		return // The keys of an unpacked slice are unknown.
+	}
+	if level, ok := logLevel(pass.TypesInfo, call); !ok || levelName(level) != levelError {
+		return
+	}
+	if _, ok := enclosingErrorCheck(pass.TypesInfo, cursor, []string{errorBlockIf}); !ok {
+		return
+	}
+
+	present := argumentKeys(pass.TypesInfo, args)
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		present = append(present, argumentKeys(pass.TypesInfo, loggerArgs(pass.TypesInfo, outermostFunc(cursor), sel.X, call.Pos()))...)
+	}
+	if !slices.Contains(present, errKey) {
+		pass.ReportRangef(call, "the log call should include the error under the %q key", errKey)
+	}
+}
+
+func noLogAndReturn(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr, exceptions []string) {
+	var errVars []types.Object
+	for _, arg := range args {
+		ast.Inspect(arg, func(node ast.Node) bool {
+			ident, ok := node.(*ast.Ident)
+			if !ok || !isError(pass.TypesInfo, ident) {
+				return true
+			}
+			if v, ok := pass.TypesInfo.Uses[ident].(*types.Var); ok && !slices.Contains(errVars, types.Object(v)) {
+				errVars = append(errVars, v)
+			}
+			return true
+		})
+	}
+	if len(errVars) == 0 {
+		return
+	}
+
+	if fn, ok := outermostFunc(cursor).(*ast.FuncDecl); ok {
+		if obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func); ok && slices.ContainsFunc(exceptions, func(pattern string) bool {
+			return cachedRegexp(keyPatternRegexp(pattern)).MatchString(obj.FullName())
+		}) {
+			return
+		}
+	}
+
+	var stmts []ast.Stmt
+	for cursor := range cursor.Enclosing(new(ast.BlockStmt), new(ast.CaseClause), new(ast.CommClause)) {
+		switch node := cursor.Node().(type) {
+		case *ast.BlockStmt:
+			stmts = node.List
+		case *ast.CaseClause:
+			stmts = node.Body
+		case *ast.CommClause:
+			stmts = node.Body
+		}
+		break // Only the innermost block is considered.
+	}
+
+	for _, stmt := range stmts {
+		ret, ok := stmt.(*ast.ReturnStmt)
+		if !ok || ret.Pos() < call.End() {
+			continue
+		}
+		for _, result := range ret.Results {
+			if !isError(pass.TypesInfo, result) || !slices.ContainsFunc(errVars, func(v types.Object) bool {
+				return usesObject(pass.TypesInfo, result, v)
+			}) {
+				continue
+			}
+			pass.Report(analysis.Diagnostic{
+				Pos:     call.Pos(),
+				End:     call.End(),
+				Message: "the error should be either logged or returned, not both",
+				Related: []analysis.RelatedInformation{{
+					Pos:     result.Pos(),
+					End:     result.End(),
+					Message: "the error is returned here",
+				}},
+			})
+			return
+		}
+	}
+}
+
+// typedAttrFuncs maps value types to the typed attribute constructors.
+var typedAttrFuncs = map[string]string{
+	"int":           "Int",
+	"int64":         "Int64",
+	"uint64":        "Uint64",
+	"float64":       "Float64",
+	"string":        "String",
+	"bool":          "Bool",
+	"time.Time":     "Time",
+	"time.Duration": "Duration",
+}
+
+func typedAttrs(pass *analysis.Pass, call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 2 {
+		return
+	}
+
+	report := func(fn string, value ast.Expr) {
+		diag := analysis.Diagnostic{
+			Pos:     call.Pos(),
+			End:     call.End(),
+			Message: fmt.Sprintf("use slog.%s instead", fn),
+		}
+		edits := []analysis.TextEdit{{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: []byte(fn)}}
+		if value != call.Args[1] {
+			text, ok := sourceText(pass, value.Pos(), value.End())
+			if !ok {
+				return
+			}
+			edits = append(edits, analysis.TextEdit{Pos: call.Args[1].Pos(), End: call.Args[1].End(), NewText: text})
+		}
+		diag.SuggestedFixes = []analysis.SuggestedFix{{TextEdits: edits}}
+		pass.Report(diag)
+	}
+
+	switch funcName(pass.TypesInfo, call) {
+	case "log/slog.Any":
+		typ := pass.TypesInfo.TypeOf(call.Args[1])
+		if typ == nil {
+			return
+		}
+		if fn, ok := typedAttrFuncs[types.Default(typ).String()]; ok {
+			report(fn, call.Args[1])
+		}
+	case "log/slog.String":
+		conv, ok := ast.Unparen(call.Args[1]).(*ast.CallExpr)
+		if !ok {
+			return
+		}
+		switch name := funcName(pass.TypesInfo, conv); name {
+		case "(time.Time).String", "(time.Duration).String":
+			// Only the receiver itself can be passed to the typed constructor,
+			// so pointers, embedded fields, and method expressions (e.g. time.Time.String(t)) are skipped.
+			recvType := typeutil.StaticCallee(pass.TypesInfo, conv).Signature().Recv().Type()
+			recv := conv.Fun.(*ast.SelectorExpr).X
+			if tv := pass.TypesInfo.Types[recv]; tv.IsValue() && types.Identical(tv.Type, recvType) {
+				report(typedAttrFuncs[recvType.String()], recv)
+			}
+		case "strconv.Itoa", "strconv.FormatBool":
+			if len(conv.Args) == 1 {
+				report(map[string]string{"strconv.Itoa": "Int", "strconv.FormatBool": "Bool"}[name], conv.Args[0])
+			}
+		case "strconv.FormatInt", "strconv.FormatUint":
+			if len(conv.Args) != 2 {
+				return
+			}
+			if base := pass.TypesInfo.Types[conv.Args[1]].Value; base == nil || base.String() != "10" {
+				return // Only the decimal representation is equivalent.
+			}
+			report(map[string]string{"strconv.FormatInt": "Int64", "strconv.FormatUint": "Uint64"}[name], conv.Args[0])
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package sloglint
+
+import (
+	"fmt"
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+func groupNamingCase(pass *analysis.Pass, name ast.Expr, caseName string, initialisms []string) {
+	group, ok := constKeyName(pass.TypesInfo, name)
+	if !ok {
+		return
+	}
+
+	caseFn := keyCaseFunc(caseName, initialisms, false)
+	if group == caseFn(group) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     name.Pos(),
+		End:     name.End(),
+		Message: fmt.Sprintf("group names should be written in %s", caseFn(caseName+" case")),
+	}
+	fixKeyLiteral(pass, &diag, name, caseFn(group))
+	pass.Report(diag)
+}
+
+func allowedGroups(pass *analysis.Pass, name ast.Expr, allowed []string) {
+	if group, ok := constKeyName(pass.TypesInfo, name); ok && !matchKeys(allowed, group) {
+		pass.ReportRangef(name, "the %q group is not allowed and should not be used", group)
+	}
+}
+
+func forbiddenGroups(pass *analysis.Pass, name ast.Expr, forbidden []string) {
+	if group, ok := constKeyName(pass.TypesInfo, name); ok && matchKeys(forbidden, group) {
+		pass.ReportRangef(name, "the %q group is forbidden and should not be used", group)
+	}
+}
+
+func maxGroupDepth(pass *analysis.Pass, call *ast.CallExpr, depth, maxDepth int) {
+	if depth > maxDepth {
+		pass.ReportRangef(call, "groups should not be nested deeper than %d levels", maxDepth)
+	}
+}
+
+func noEmptyGroups(pass *analysis.Pass, call *ast.CallExpr) {
+	if !isGroup(pass.TypesInfo, call) {
+		return // Special case: Logger.WithGroup is never empty by itself.
+	}
+	if len(call.Args) == 1 {
+		pass.ReportRangef(call, "empty groups are ignored by handlers and should not be used")
+	}
+}
@@ -3,9 +3,15 @@ package sloglint
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
+	"maps"
+	"path/filepath"
 	"slices"
 	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/ettle/strcase"
 	"golang.org/x/tools/go/analysis"
@@ -20,28 +26,420 @@ func constantKeys(pass *analysis.Pass, key ast.Expr) {
 			return
 		}
 	}
+	if isStringLiteral(key) {
+		return // Raw keys are reported by rawKeys, once the whole package is analyzed.
+	}
+
 	name, _ := keyName(key)
 	pass.ReportRangef(key, "the %q key should be a constant", name)
 }
 
-func allowedKeys(pass *analysis.Pass, key ast.Expr, allowed []string) {
-	if name, ok := keyName(key); ok && !slices.Contains(allowed, name) {
+// rawKeys reports the keys that are string literals and suggests replacing them with constants.
+// The fixes are computed for the whole package, so that a new constant is declared only once,
+// even if the same key is used in several files, and all the new constants end up in a single block.
+func rawKeys(pass *analysis.Pass, keys []keyUsage, keyPkgs []string) {
+	keys = slices.DeleteFunc(slices.Clone(keys), func(key keyUsage) bool { return !isStringLiteral(key.expr) })
+
+	existing := make(map[ast.Expr]string) // The key -> the constant to replace it with.
+	newConsts := make(map[string]string)  // The name of the new constant -> the key name.
+	for _, key := range keys {
+		if constName, ok := stringConstant(pass.Pkg.Scope(), key.name, false); ok {
+			if visibleAt(pass, constName, key.expr.Pos()) {
+				existing[key.expr] = constName
+			}
+			continue
+		}
+		if constName, ok := keyConstant(pass, key.expr.Pos(), keyPkgs, key.name); ok {
+			existing[key.expr] = constName
+			continue
+		}
+		constName := newConstName(key.name)
+		if _, ok := newConsts[constName]; !ok && token.IsIdentifier(constName) && pass.Pkg.Scope().Lookup(constName) == nil {
+			newConsts[constName] = key.name
+		}
+	}
+
+	var decl analysis.TextEdit
+	if len(newConsts) > 0 {
+		decl = constDecl(pass, newConsts)
+	}
+
+	for _, key := range keys {
+		diag := analysis.Diagnostic{
+			Pos:     key.expr.Pos(),
+			End:     key.expr.End(),
+			Message: fmt.Sprintf("the %q key should be a constant", key.name),
+		}
+
+		replace := func(constName string) analysis.TextEdit {
+			return analysis.TextEdit{Pos: key.expr.Pos(), End: key.expr.End(), NewText: []byte(constName)}
+		}
+		if constName, ok := existing[key.expr]; ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{TextEdits: []analysis.TextEdit{replace(constName)}}}
+		} else if constName := newConstName(key.name); newConsts[constName] == key.name && decl.NewText != nil && visibleAt(pass, constName, key.expr.Pos()) {
+			// Every fix declares all the new constants, so the fixes can be applied in any combination;
+			// the identical declarations are merged when the fixes are applied together.
+			diag.SuggestedFixes = []analysis.SuggestedFix{{TextEdits: []analysis.TextEdit{decl, replace(constName)}}}
+		}
+
+		pass.Report(diag)
+	}
+}
+
+// newConstName returns the name of the constant to declare for the given key.
+func newConstName(key string) string {
+	return strcase.ToGoCamel(key) + "Key"
+}
+
+// constDecl declares the given constants in a deterministic file of the package:
+// the first file (by name) that has a block of string constants, otherwise the first file.
+// Test files are only used if there are no other files.
+func constDecl(pass *analysis.Pass, consts map[string]string) analysis.TextEdit {
+	files := slices.Clone(pass.Files)
+	slices.SortFunc(files, func(a, b *ast.File) int {
+		nameA, nameB := pass.Fset.File(a.Pos()).Name(), pass.Fset.File(b.Pos()).Name()
+		testA, testB := strings.HasSuffix(nameA, "_test.go"), strings.HasSuffix(nameB, "_test.go")
+		if testA != testB {
+			if testA {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(nameA, nameB)
+	})
+
+	var specs strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(consts)) {
+		fmt.Fprintf(&specs, "\t%s = %s\n", name, strconv.Quote(consts[name]))
+	}
+
+	for _, file := range files {
+		if block := stringConstBlock(file); block != nil {
+			return analysis.TextEdit{Pos: block.Rparen, End: block.Rparen, NewText: []byte(specs.String())}
+		}
+	}
+
+	file := files[0]
+	pos := file.Name.End()
+	for _, d := range file.Decls {
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			pos = gd.End()
+		}
+	}
+	return analysis.TextEdit{Pos: pos, End: pos, NewText: fmt.Appendf(nil, "\n\nconst (\n%s)", specs.String())}
+}
+
+// stringConstBlock returns the first parenthesized const declaration of the file
+// that consists only of string constants, e.g. log keys.
+func stringConstBlock(file *ast.File) *ast.GenDecl {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST || !gd.Lparen.IsValid() {
+			continue
+		}
+		if slices.ContainsFunc(gd.Specs, func(spec ast.Spec) bool {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Values) != len(vs.Names) {
+				return true
+			}
+			return slices.ContainsFunc(vs.Values, func(v ast.Expr) bool {
+				lit, ok := v.(*ast.BasicLit)
+				return !ok || lit.Kind != token.STRING
+			})
+		}) {
+			continue
+		}
+		return gd
+	}
+	return nil
+}
+
+func allowedKeys(pass *analysis.Pass, key ast.Expr, allowed, keyPkgs []string) {
+	name, ok := keyName(key)
+	if !ok || matchKeys(allowed, name) {
+		return
+	}
+
+	suggestion, ok := closestKey(name, slices.DeleteFunc(expandKeyPresets(allowed), isKeyPattern))
+	if !ok {
 		pass.ReportRangef(key, "the %q key is not allowed and should not be used", name)
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     key.Pos(),
+		End:     key.End(),
+		Message: fmt.Sprintf("the %q key is not allowed; did you mean %q?", name, suggestion),
+	}
+	if _, ok := key.(*ast.BasicLit); ok {
+		diag.SuggestedFixes = append(diag.SuggestedFixes, analysis.SuggestedFix{
+			Message: "Replace with the allowed key",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     key.Pos(),
+				End:     key.End(),
+				NewText: strconv.AppendQuote(nil, suggestion),
+			}},
+		})
+	}
+	if constName, ok := keyConstant(pass, key.Pos(), keyPkgs, suggestion); ok {
+		diag.SuggestedFixes = append(diag.SuggestedFixes, analysis.SuggestedFix{
+			Message: "Replace with the allowed key constant",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     key.Pos(),
+				End:     key.End(),
+				NewText: []byte(constName),
+			}},
+		})
 	}
+	pass.Report(diag)
+}
+
+// closestKey returns the key that is the most similar to the given one, if there is a similar enough key.
+func closestKey(name string, keys []string) (string, bool) {
+	maxDistance := max(1, len(name)/3)
+
+	closest, minDistance := "", maxDistance+1
+	for _, key := range keys {
+		if d := levenshtein(name, key); d < minDistance {
+			closest, minDistance = key, d
+		}
+	}
+
+	return closest, closest != ""
 }
 
 func forbiddenKeys(pass *analysis.Pass, key ast.Expr, forbidden []string) {
-	if name, ok := keyName(key); ok && slices.Contains(forbidden, name) {
+	if name, ok := keyName(key); ok && matchKeys(forbidden, name) {
 		pass.ReportRangef(key, "the %q key is forbidden and should not be used", name)
 	}
 }
 
-func keyNamingCase(pass *analysis.Pass, key ast.Expr, caseName string) {
-	name, ok := keyName(key)
+func keyPresets(pass *analysis.Pass, key ast.Expr, presets []string) {
+	name, ok := constKeyName(pass.TypesInfo, key)
+	if !ok {
+		return
+	}
+
+	for _, presetName := range presets {
+		preset := loadKeyPresets()[presetName]
+		if slices.Contains(preset.keys, name) {
+			return
+		}
+		canonical, ok := preset.normalized[normalizeKey(name)]
+		if !ok {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     key.Pos(),
+			End:     key.End(),
+			Message: fmt.Sprintf("the %q key should be %q according to %s", name, canonical, preset.title),
+		}
+		fixKeyLiteral(pass, &diag, key, canonical)
+		pass.Report(diag)
+		return
+	}
+}
+
+func renamedKeys(pass *analysis.Pass, key ast.Expr, renamed map[string]string, keyPkgs []string) {
+	name, ok := constKeyName(pass.TypesInfo, key)
+	if !ok {
+		return
+	}
+	newName, ok := renamed[name]
+	if !ok {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     key.Pos(),
+		End:     key.End(),
+		Message: fmt.Sprintf("the %q key is deprecated, use %q instead", name, newName),
+	}
+
+	switch key := key.(type) {
+	case *ast.BasicLit:
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			TextEdits: []analysis.TextEdit{{
+				Pos:     key.Pos(),
+				End:     key.End(),
+				NewText: strconv.AppendQuote(nil, newName),
+			}},
+		}}
+	case *ast.SelectorExpr:
+		// The key is defined in another package, e.g. keys.Foo.
+		// If it's a key package, replace the constant with the one that has the new value.
+		c, ok := pass.TypesInfo.ObjectOf(key.Sel).(*types.Const)
+		if !ok || !slices.Contains(keyPkgs, c.Pkg().Path()) {
+			break
+		}
+		if constName, ok := stringConstant(c.Pkg().Scope(), newName, true); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				TextEdits: []analysis.TextEdit{{
+					Pos:     key.Sel.Pos(),
+					End:     key.Sel.End(),
+					NewText: []byte(constName),
+				}},
+			}}
+		}
+	}
+
+	pass.Report(diag)
+}
+
+func keyNamespace(pass *analysis.Pass, key ast.Expr, groups []string, namespaces map[string]string) {
+	namespace, ok := packageSetting(pass.Pkg.Path(), namespaces)
+	if !ok {
+		return
+	}
+
+	name, ok := constKeyName(pass.TypesInfo, key)
+	if !ok || name == namespace || strings.HasPrefix(name, namespace+".") || slices.Contains(groups, namespace) {
+		return
+	}
+
+	pass.ReportRangef(key, "the %q key should be prefixed with %q or put inside the %q group", name, namespace+".", namespace)
+}
+
+func keyNamingCase(pass *analysis.Pass, key ast.Expr, caseName string, initialisms []string, segments bool, exceptions []string) {
+	name, ok := constKeyName(pass.TypesInfo, key)
+	if !ok || matchKeys(exceptions, name) {
+		return
+	}
+
+	caseFn := keyCaseFunc(caseName, initialisms, segments)
+	if name == caseFn(name) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     key.Pos(),
+		End:     key.End(),
+		Message: fmt.Sprintf("keys should be written in %s", caseFn(caseName+" case")),
+	}
+
+	fixKeyLiteral(pass, &diag, key, caseFn(name))
+	pass.Report(diag)
+}
+
+// fixKeyLiteral adds a suggested fix that replaces the key with a new one.
+// If the key is a constant, its declaration is fixed instead, so that the key remains a constant.
+// If the constant is declared in another package, its declaration is pointed out instead.
+func fixKeyLiteral(pass *analysis.Pass, diag *analysis.Diagnostic, key ast.Expr, newKey string) {
+	var lit *ast.BasicLit
+	switch key := key.(type) {
+	case *ast.BasicLit:
+		lit = key
+	case *ast.Ident:
+		if c, ok := pass.TypesInfo.ObjectOf(key).(*types.Const); ok {
+			lit = constantLiteral(pass, c)
+		}
+	case *ast.SelectorExpr:
+		if c, ok := pass.TypesInfo.ObjectOf(key.Sel).(*types.Const); ok && c.Pkg() != pass.Pkg {
+			diag.Related = []analysis.RelatedInformation{{
+				Pos:     c.Pos(),
+				End:     c.Pos(),
+				Message: fmt.Sprintf("the %s constant is declared here", c.Name()),
+			}}
+		}
+	}
+
+	if lit != nil {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			TextEdits: []analysis.TextEdit{{
+				Pos:     lit.Pos(),
+				End:     lit.End(),
+				NewText: strconv.AppendQuote(nil, newKey),
+			}},
+		}}
+	}
+}
+
+func safeKeys(pass *analysis.Pass, key ast.Expr, caseFn func(string) string) {
+	name, ok := constKeyName(pass.TypesInfo, key)
 	if !ok {
 		return
 	}
 
+	problem, ok := unsafeKeyProblem(name)
+	if !ok {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     key.Pos(),
+		End:     key.End(),
+		Message: fmt.Sprintf("the %q key %s", name, problem),
+	}
+
+	// Only suggest a fix if we know the naming case to convert the sanitized key to.
+	if caseFn != nil {
+		sanitized := strings.Map(func(r rune) rune {
+			if isSafeKeyRune(r) {
+				return r
+			}
+			return ' ' // strcase treats spaces as word delimiters.
+		}, name)
+		sanitized = strings.TrimLeftFunc(caseFn(sanitized), func(r rune) bool {
+			return r == '_' || r == '-' || r == '.'
+		})
+		// Leading digits are not dropped, since that would change the meaning of the key (e.g. "1st_place"),
+		// so such keys are left for the user to rename.
+		if _, unsafe := unsafeKeyProblem(sanitized); !unsafe {
+			fixKeyLiteral(pass, &diag, key, sanitized)
+		}
+	}
+
+	pass.Report(diag)
+}
+
+// unsafeKeyProblem describes why the key may be rendered badly by the standard handlers or break log parsers.
+// It returns false if the key is safe.
+func unsafeKeyProblem(name string) (string, bool) {
+	if name == "" {
+		return "is empty, which makes slog.TextHandler quote it", true
+	}
+	if r := rune(name[0]); r >= '0' && r <= '9' {
+		return "starts with a digit, which may break log parsers", true
+	}
+
+	for _, r := range name {
+		switch {
+		case isSafeKeyRune(r):
+			continue
+		case r == ' ':
+			return "contains a space, which makes slog.TextHandler quote it", true
+		case r == '=':
+			return `contains "=", which makes slog.TextHandler quote it`, true
+		case r == '"':
+			return "contains a quote, which makes both slog.TextHandler and slog.JSONHandler escape it", true
+		case unicode.IsControl(r) || unicode.IsSpace(r):
+			return "contains a control character, which makes both slog.TextHandler and slog.JSONHandler escape it", true
+		case r >= utf8.RuneSelf:
+			return "contains a non-ASCII character, which may break log parsers", true
+		default:
+			return fmt.Sprintf("contains %q, which may break log parsers", r), true
+		}
+	}
+
+	return "", false
+}
+
+// isSafeKeyRune reports whether the rune can be safely used in a key.
+func isSafeKeyRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' || r == '.'
+}
+
+// keyCaseFunc returns a function that converts a key to the given naming case.
+// The initialisms are only used for camel and pascal cases.
+// If segments is true, each segment of a dotted key is converted separately.
+func keyCaseFunc(caseName string, initialisms []string, segments bool) func(string) string {
+	overrides := make(map[string]bool, len(initialisms))
+	for _, s := range initialisms {
+		overrides[strings.ToUpper(s)] = true
+	}
+	caser := strcase.NewCaser(false, overrides, nil)
+
 	var caseFn func(string) string
 	switch caseName {
 	case keyNamingCaseSnake:
@@ -49,25 +447,212 @@ func keyNamingCase(pass *analysis.Pass, key ast.Expr, caseName string) {
 	case keyNamingCaseKebab:
 		caseFn = strcase.ToKebab
 	case keyNamingCaseCamel:
-		caseFn = strcase.ToCamel
+		caseFn = caser.ToCamel
 	case keyNamingCasePascal:
-		caseFn = strcase.ToPascal
+		caseFn = caser.ToPascal
+	case keyNamingCaseDot:
+		caseFn = func(s string) string { return strcase.ToCase(s, strcase.LowerCase, '.') }
+	case keyNamingCaseScreamingSnake:
+		caseFn = strcase.ToSNAKE
 	}
 
-	if name == caseFn(name) {
+	if !segments {
+		return caseFn
+	}
+
+	return func(s string) string {
+		segments := strings.Split(s, ".")
+		for i := range segments {
+			segments[i] = caseFn(segments[i])
+		}
+		return strings.Join(segments, ".")
+	}
+}
+
+func keyNamingPattern(pass *analysis.Pass, key ast.Expr, pattern string, exceptions []string) {
+	name, ok := constKeyName(pass.TypesInfo, key)
+	if !ok || matchKeys(exceptions, name) {
 		return
 	}
+	if !cachedRegexp(pattern).MatchString(name) {
+		pass.ReportRangef(key, "keys should match the %q pattern", pattern)
+	}
+}
 
-	pass.Report(analysis.Diagnostic{
-		Pos:     key.Pos(),
-		End:     key.End(),
-		Message: fmt.Sprintf("keys should be written in %s", caseFn(caseName+" case")),
-		SuggestedFixes: []analysis.SuggestedFix{{
-			TextEdits: []analysis.TextEdit{{
-				Pos:     key.Pos(),
-				End:     key.End(),
-				NewText: strconv.AppendQuote(nil, caseFn(name)),
-			}},
-		}},
+// keyTypesFact records the value types of the log keys used in a package, by the key paths (see keyUsage.path).
+type keyTypesFact struct {
+	Types map[string]keyType
+}
+
+// keyType describes the first use of a log key in a package.
+type keyType struct {
+	Type string
+	Pos  string // Formatted as "file.go:line".
+}
+
+func (*keyTypesFact) AFact() {}
+
+func (f *keyTypesFact) String() string { return fmt.Sprintf("keyTypes(%d)", len(f.Types)) }
+
+func consistentKeyTypes(pass *analysis.Pass, keys []keyUsage) {
+	type upstreamKeyType struct {
+		keyType
+		pkg string
+	}
+
+	// Sort the facts by package path to keep the reports deterministic.
+	facts := pass.AllPackageFacts()
+	slices.SortFunc(facts, func(a, b analysis.PackageFact) int {
+		return strings.Compare(a.Package.Path(), b.Package.Path())
 	})
+
+	upstream := make(map[string]upstreamKeyType)
+	for _, fact := range facts {
+		if fact.Package == pass.Pkg {
+			continue
+		}
+		f, ok := fact.Fact.(*keyTypesFact)
+		if !ok {
+			continue
+		}
+		for name, typ := range f.Types {
+			if _, ok := upstream[name]; !ok {
+				upstream[name] = upstreamKeyType{typ, fact.Package.Path()}
+			}
+		}
+	}
+
+	local := make(map[string]keyType)
+	for _, key := range keys {
+		if key.value == nil {
+			continue
+		}
+		typ := valueTypeName(pass.TypesInfo.TypeOf(key.value))
+		if typ == "" {
+			continue
+		}
+
+		path := key.path()
+		if first, ok := upstream[path]; ok && first.Type != typ {
+			pass.ReportRangef(key.expr, "the %q key has a value of type %s, but %s was first used in %s (%s)", path, typ, first.Type, first.pkg, first.Pos)
+			continue
+		}
+		if first, ok := local[path]; ok && first.Type != typ {
+			pass.ReportRangef(key.expr, "the %q key has a value of type %s, but %s was first used at %s", path, typ, first.Type, first.Pos)
+			continue
+		}
+		if _, ok := local[path]; !ok {
+			pos := pass.Fset.Position(key.expr.Pos())
+			local[path] = keyType{Type: typ, Pos: fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)}
+		}
+	}
+
+	if len(local) > 0 {
+		pass.ExportPackageFact(&keyTypesFact{Types: local})
+	}
+}
+
+// valueTypeName returns the name of the type of a log value, as it will be seen by log storages.
+// Types that only differ in size (e.g. int and int64) are considered the same.
+// If the type cannot be determined statically (e.g. an interface), it returns an empty string.
+func valueTypeName(typ types.Type) string {
+	if typ == nil || types.IsInterface(typ) {
+		return ""
+	}
+
+	typ = types.Default(typ)
+	switch types.TypeString(typ, nil) {
+	case "time.Time", "time.Duration":
+		return types.TypeString(typ, (*types.Package).Name)
+	case "log/slog.Value":
+		return "" // The kind of the value is only known at runtime.
+	}
+
+	if basic, ok := typ.Underlying().(*types.Basic); ok {
+		switch info := basic.Info(); {
+		case info&types.IsInteger != 0:
+			return "integer"
+		case info&types.IsFloat != 0:
+			return "float"
+		case info&types.IsString != 0:
+			return "string"
+		case info&types.IsBoolean != 0:
+			return "bool"
+		}
+	}
+
+	return types.TypeString(typ, (*types.Package).Name)
+}
+
+// keyNamesFact records how many times each log key path is used in a package.
+type keyNamesFact struct {
+	Counts map[string]int
+}
+
+func (*keyNamesFact) AFact() {}
+
+func (f *keyNamesFact) String() string { return fmt.Sprintf("keyNames(%d)", len(f.Counts)) }
+
+func consistentKeyNames(pass *analysis.Pass, keys []keyUsage) {
+	// Group names are not keys on their own, so only the keys inside the groups are counted.
+	keys = slices.DeleteFunc(slices.Clone(keys), func(key keyUsage) bool { return key.group })
+
+	local := make(map[string]int)
+	for _, key := range keys {
+		local[key.path()]++
+	}
+
+	counts := maps.Clone(local)
+	for _, fact := range pass.AllPackageFacts() {
+		if f, ok := fact.Fact.(*keyNamesFact); ok && fact.Package != pass.Pkg {
+			for path, n := range f.Counts {
+				counts[path] += n
+			}
+		}
+	}
+
+	// The most used spelling of a key is considered the correct one.
+	// If there is a tie, the alphabetically first spelling wins to keep the reports deterministic.
+	dominant := make(map[string]string)
+	for path, n := range counts {
+		normalized := normalizeKeyPath(path)
+		if d, ok := dominant[normalized]; !ok || n > counts[d] || (n == counts[d] && path < d) {
+			dominant[normalized] = path
+		}
+	}
+
+	for _, key := range keys {
+		path := key.path()
+		if dominant[normalizeKeyPath(path)] == path {
+			continue
+		}
+		// The dominant path only differs in the last segment,
+		// so it always starts with the same groups as the key.
+		spelling := strings.TrimPrefix(dominant[normalizeKeyPath(path)], strings.TrimSuffix(path, key.name))
+
+		diag := analysis.Diagnostic{
+			Pos:     key.expr.Pos(),
+			End:     key.expr.End(),
+			Message: fmt.Sprintf("the %q key should be spelled as %q to match its other uses", key.name, spelling),
+		}
+		fixKeyLiteral(pass, &diag, key.expr, spelling)
+		pass.Report(diag)
+	}
+
+	if len(local) > 0 {
+		pass.ExportPackageFact(&keyNamesFact{Counts: local})
+	}
+}
+
+// normalizeKeyPath normalizes the last segment of the key path, keeping the groups as is,
+// since the same key in different groups is a different field.
+func normalizeKeyPath(path string) string {
+	i := strings.LastIndexByte(path, '.')
+	return path[:i+1] + normalizeKey(path[i+1:])
+}
+
+// normalizeKey returns the key with all the words lowercased and concatenated together,
+// so that e.g. "userId", "user_id", and "user-ID" are all normalized to "userid".
+func normalizeKey(name string) string {
+	return strcase.ToCase(name, strcase.LowerCase, 0)
 }
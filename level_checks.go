@@ -0,0 +1,321 @@
+package sloglint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+func errorLevel(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr, minLevel string, blocks []string) {
+	level, ok := logLevel(pass.TypesInfo, call)
+	if !ok {
+		return
+	}
+
+	errVar, ok := enclosingErrorCheck(pass.TypesInfo, cursor, blocks)
+	if !ok {
+		if levelName(level) == levelError && !call.Ellipsis.IsValid() && !slices.ContainsFunc(args, func(arg ast.Expr) bool {
+			return containsError(pass.TypesInfo, arg)
+		}) {
+			pass.ReportRangef(call, "Error-level log calls should include an error")
+		}
+		return
+	}
+
+	if level >= parseLevel(minLevel) {
+		return
+	}
+	if slices.ContainsFunc(args, func(arg ast.Expr) bool {
+		return usesObject(pass.TypesInfo, arg, errVar)
+	}) {
+		pass.ReportRangef(call, "errors should be logged at the %s level or higher", levelTitle(minLevel))
+	}
+}
+
+// levelTitle returns the level name as it's written in the slog constants, e.g. "Warn" for "warn".
+func levelTitle(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// parseLevel returns the level with the given name, see [levelName].
+func parseLevel(name string) slog.Level {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(name)) // The name must be checked in Options.validate beforehand.
+	return level
+}
+
+var standardLevels = map[slog.Level]string{
+	slog.LevelDebug: "LevelDebug",
+	slog.LevelInfo:  "LevelInfo",
+	slog.LevelWarn:  "LevelWarn",
+	slog.LevelError: "LevelError",
+}
+
+func namedLevel(pass *analysis.Pass, call *ast.CallExpr, customLevels []string) {
+	switch funcName(pass.TypesInfo, call) {
+	case "log/slog.Log", "log/slog.LogAttrs", "(*log/slog.Logger).Log", "(*log/slog.Logger).LogAttrs":
+	default:
+		return
+	}
+	if len(call.Args) < 2 {
+		return
+	}
+
+	level := ast.Unparen(call.Args[1])
+	var obj types.Object
+	switch expr := level.(type) {
+	case *ast.Ident:
+		obj = pass.TypesInfo.Uses[expr]
+	case *ast.SelectorExpr:
+		obj = pass.TypesInfo.Uses[expr.Sel]
+	}
+	if c, ok := obj.(*types.Const); ok && c.Pkg() != nil {
+		name := c.Pkg().Path() + "." + c.Name()
+		if (c.Pkg().Path() == "log/slog" && strings.HasPrefix(c.Name(), "Level")) || slices.Contains(customLevels, name) {
+			return
+		}
+		pass.ReportRangef(level, "%s is not an allowed level constant, add %q to the custom levels to allow it", c.Name(), name)
+		return
+	}
+
+	value := pass.TypesInfo.Types[level].Value
+	if value == nil {
+		if !isLevelerCall(pass.TypesInfo, level) {
+			pass.ReportRangef(level, "dynamic levels should come from a slog.Leveler")
+		}
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     level.Pos(),
+		End:     level.End(),
+		Message: "levels should be named constants",
+	}
+	if n, ok := constant.Int64Val(value); ok {
+		qualifier, ok := importName(pass, call.Pos(), "log/slog")
+		if name, known := standardLevels[slog.Level(n)]; known && ok {
+			diag.Message = fmt.Sprintf("levels should be named constants, use %s.%s instead", qualifier, name)
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				TextEdits: []analysis.TextEdit{{
+					Pos:     level.Pos(),
+					End:     level.End(),
+					NewText: []byte(qualifier + "." + name),
+				}},
+			}}
+		}
+	}
+	pass.Report(diag)
+}
+
+// isLevelerCall reports whether the expression is a call to the Level method of a [slog.Leveler].
+func isLevelerCall(info *types.Info, expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Level" {
+		return false
+	}
+	fn, ok := info.Uses[sel.Sel].(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := fn.Signature()
+	return sig.Recv() != nil && sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+		sig.Results().At(0).Type().String() == "log/slog.Level"
+}
+
+func allowedLevels(pass *analysis.Pass, call *ast.CallExpr, allowed map[string][]string) {
+	levels, ok := packageSetting(pass.Pkg.Path(), allowed)
+	if !ok {
+		return
+	}
+	level, ok := logLevel(pass.TypesInfo, call)
+	if !ok {
+		return
+	}
+	if name := levelName(level); !slices.Contains(levels, name) {
+		pass.ReportRangef(call, "the %s level is not allowed in this package", levelTitle(name))
+	}
+}
+
+func guardedDebugArgs(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor, args []ast.Expr) {
+	level, ok := logLevel(pass.TypesInfo, call)
+	if !ok || levelName(level) != levelDebug || isEnabledGuarded(pass.TypesInfo, cursor) {
+		return
+	}
+
+	var expensive ast.Node
+	for _, arg := range args {
+		if expensive = expensiveExpr(pass.TypesInfo, arg); expensive != nil {
+			break
+		}
+	}
+	if expensive == nil {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     expensive.Pos(),
+		End:     expensive.End(),
+		Message: "expensive arguments of Debug-level log calls should be guarded by Enabled",
+	}
+	if edits, ok := enabledGuardFix(pass, call, cursor); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{TextEdits: edits}}
+	}
+	pass.Report(diag)
+}
+
+// isEnabledGuarded reports whether the cursor is inside an "if logger.Enabled(ctx, slog.LevelDebug)" block, within the same function.
+func isEnabledGuarded(info *types.Info, cursor inspector.Cursor) bool {
+	pos := cursor.Node().Pos()
+	for cursor := range cursor.Enclosing(new(ast.IfStmt), new(ast.FuncDecl), new(ast.FuncLit)) {
+		stmt, ok := cursor.Node().(*ast.IfStmt)
+		if !ok {
+			return false // Don't cross function boundaries.
+		}
+		if pos >= stmt.Body.Pos() && pos <= stmt.Body.End() && isDebugEnabled(info, stmt.Cond) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDebugEnabled reports whether the condition holds only if the Debug level is enabled,
+// i.e. it's an Enabled call with slog.LevelDebug or lower, possibly combined with other conditions using "&&".
+func isDebugEnabled(info *types.Info, cond ast.Expr) bool {
+	switch cond := ast.Unparen(cond).(type) {
+	case *ast.BinaryExpr:
+		return cond.Op == token.LAND && (isDebugEnabled(info, cond.X) || isDebugEnabled(info, cond.Y))
+	case *ast.CallExpr:
+		sel, ok := cond.Fun.(*ast.SelectorExpr)
+		if !ok || len(cond.Args) != 2 {
+			return false
+		}
+		// Don't use funcName here, since it doesn't support interface methods, e.g. slog.Handler.Enabled.
+		fn, ok := info.Uses[sel.Sel].(*types.Func)
+		if !ok || (fn.FullName() != "(*log/slog.Logger).Enabled" && fn.FullName() != "(log/slog.Handler).Enabled") {
+			return false
+		}
+		value := info.Types[cond.Args[1]].Value
+		if value == nil {
+			return false
+		}
+		level, ok := constant.Int64Val(value)
+		return ok && level <= int64(slog.LevelDebug)
+	}
+	return false
+}
+
+// expensiveExpr returns the first part of the expression that needs non-trivial computation,
+// such as a function call or a slice/map literal, or nil if there is none.
+// Values implementing [slog.LogValuer] are not considered expensive, since they are resolved lazily.
+func expensiveExpr(info *types.Info, expr ast.Expr) ast.Node {
+	var expensive ast.Node
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if expensive != nil {
+			return false
+		}
+		if expr, ok := node.(ast.Expr); ok && isLogValuer(info.TypeOf(expr)) {
+			return false
+		}
+		switch node := node.(type) {
+		case *ast.FuncLit:
+			return false // The function is not called here.
+		case *ast.CompositeLit:
+			switch info.TypeOf(node).Underlying().(type) {
+			case *types.Slice, *types.Map:
+				expensive = node
+			}
+		case *ast.CallExpr:
+			if info.Types[node.Fun].IsType() {
+				return true // A type conversion.
+			}
+			if ident, ok := ast.Unparen(node.Fun).(*ast.Ident); ok {
+				if b, ok := info.Uses[ident].(*types.Builtin); ok && b.Name() != "make" && b.Name() != "append" && b.Name() != "new" {
+					return true // E.g. len(s).
+				}
+			}
+			if strings.HasPrefix(funcName(info, node), "log/slog.") {
+				return true // E.g. slog.Int(key, value), the value is checked separately.
+			}
+			expensive = node
+		}
+		return expensive == nil
+	})
+	return expensive
+}
+
+// enabledGuardFix wraps the log call statement in an "if logger.Enabled(ctx, slog.LevelDebug)" block.
+// A context must be passed to the log call, and the logger must be known, i.e. custom functions are not supported.
+func enabledGuardFix(pass *analysis.Pass, call *ast.CallExpr, cursor inspector.Cursor) ([]analysis.TextEdit, bool) {
+	stmt, ok := cursor.Parent().Node().(*ast.ExprStmt)
+	if !ok {
+		return nil, false
+	}
+	qualifier, ok := importName(pass, call.Pos(), "log/slog")
+	if !ok {
+		return nil, false
+	}
+
+	var ctx ast.Expr
+	switch fn := funcName(pass.TypesInfo, call); fn {
+	case "log/slog.DebugContext", "log/slog.Log", "log/slog.LogAttrs",
+		"(*log/slog.Logger).DebugContext", "(*log/slog.Logger).Log", "(*log/slog.Logger).LogAttrs":
+		if len(call.Args) > 0 {
+			ctx = call.Args[0]
+		}
+	}
+	if ctx == nil {
+		return nil, false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	logger := qualifier + ".Default()"
+	if id, ok := sel.X.(*ast.Ident); !ok || !isPkgName(pass.TypesInfo, id) {
+		text, ok := sourceText(pass, sel.X.Pos(), sel.X.End())
+		if !ok {
+			return nil, false
+		}
+		logger = string(text)
+	}
+
+	ctxText, ok := sourceText(pass, ctx.Pos(), ctx.End())
+	if !ok {
+		return nil, false
+	}
+	stmtText, ok := sourceText(pass, stmt.Pos(), stmt.End())
+	if !ok {
+		return nil, false
+	}
+
+	file := pass.Fset.File(stmt.Pos())
+	indent, ok := sourceText(pass, file.LineStart(file.Line(stmt.Pos())), stmt.Pos())
+	if !ok || strings.TrimSpace(string(indent)) != "" {
+		return nil, false
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "if %s.Enabled(%s, %s.LevelDebug) {\n", logger, ctxText, qualifier)
+	fmt.Fprintf(&sb, "%s\t%s\n", indent, strings.ReplaceAll(string(stmtText), "\n", "\n\t"))
+	fmt.Fprintf(&sb, "%s}", indent)
+
+	return []analysis.TextEdit{{Pos: stmt.Pos(), End: stmt.End(), NewText: []byte(sb.String())}}, true
+}
+
+func isPkgName(info *types.Info, id *ast.Ident) bool {
+	_, ok := info.Uses[id].(*types.PkgName)
+	return ok
+}
@@ -4,6 +4,10 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -20,6 +24,23 @@ type Func struct {
 	ArgumentsPos int
 }
 
+// KeyRequirement describes log keys that must be present in particular log calls, see [Options.RequiredKeys].
+type KeyRequirement struct {
+	// The keys that must be present in each matching log call.
+	Keys []string
+	// Package patterns (e.g. "example.com/billing/..."), see [Options.KeyNamespaces].
+	// If empty, log calls in all packages match.
+	Packages []string
+	// Globs for the full names of the functions containing log calls, e.g. "(*example.com/api.Server).Handle*".
+	// If empty and HTTPHandlers is false, log calls in all functions match.
+	Funcs []string
+	// Match log calls in functions with an [*http.Request] parameter.
+	HTTPHandlers bool
+	// Log levels ("debug", "info", "warn", or "error").
+	// If empty, log calls of all levels match.
+	Levels []string
+}
+
 // Options contains options for the sloglint analyzer.
 type Options struct {
 	// Report the use of global loggers ("all" or "default").
@@ -40,15 +61,134 @@ type Options struct {
 	AttributesOnly bool
 	// Report two or more arguments on the same line.
 	ArgumentsOnSeparateLines bool
+	// Report calls with more than the given number of arguments, where a key-value pair is a single argument.
+	MaxArguments int
+	// Report log calls that result in more than the given number of attributes,
+	// including the ones nested in groups and the ones added to the logger with With calls earlier in the function.
+	MaxAttributes int
+	// Report errors that are logged under a key other than the given one (e.g. "err") or as strings (e.g. err.Error()),
+	// as well as Error-level log calls inside "if err != nil" blocks that don't include the error under the given key.
+	ErrorKey string
+	// Report values of the types that implement [slog.LogValuer] only with a pointer receiver,
+	// since the LogValue method is not called for them and the values are logged as is.
+	LogValuerReceivers bool
+	// Report values of particular types that don't implement [slog.LogValuer], e.g. "example.com/models.User".
+	// Globs are supported (e.g. "example.com/models.*"). Pointers, slices, arrays, and maps of the types are reported too.
+	LogValuerTypes []string
+	// Report values of struct types that don't implement [slog.LogValuer] but have sensitive fields,
+	// i.e. fields named like "Password", "Token", or "Secret", or tagged with `log:"-"`.
+	SensitiveTypes bool
+	// Report values with identifiers that match particular patterns, e.g. "password", "apiKey", or "r.Header".
+	// A value matches if any of its identifiers or selectors contains a pattern, case-insensitively.
+	SensitiveValues []string
+	// Report values of the keys with sensitive names, e.g. "password" or "*_token".
+	// Globs and regular expressions are supported, see [Options.AllowedKeys].
+	SensitiveKeys []string
+	// The full names of the functions that redact values, e.g. "example.com/redact.String".
+	// Values wrapped in these functions are not reported by [Options.SensitiveValues] and [Options.SensitiveKeys].
+	RedactionFuncs []string
+	// Report values of forbidden types, e.g. "context.Context", "*net/http.Request", "[]byte", or "*log/slog.Logger".
+	// The special "chan" and "func" types match all channels and functions respectively.
+	// The map keys are full type names, the values are optional suggestions of what to log instead (e.g. "r.URL.Path").
+	ForbiddenValueTypes map[string]string
+	// Report slog.Any calls with values of the types that have typed attribute constructors (e.g. slog.Int),
+	// as well as slog.String calls with values converted to strings (e.g. d.String() or strconv.Itoa(n)).
+	TypedAttributes bool
+	// Report arguments that are not in a particular order by their keys ("alphabetical" or "schema").
+	ArgumentOrder string
+	// The keys in the expected order, used by the "schema" argument order (e.g. "request_id", "*", "error").
+	// Globs and regular expressions are supported, see [Options.AllowedKeys]; exact keys take precedence over patterns.
+	// Keys that match nothing are expected after all the listed ones.
+	ArgumentOrderSchema []string
+	// Report groups that are followed by other arguments.
+	GroupsLast bool
 
 	// Report the use of string literals as log keys.
 	ConstantKeys bool
 	// Report the use of log keys that are not explicitly allowed.
+	// Besides exact keys, globs (e.g. "http.*") and regular expressions starting with "^" (e.g. "^x_") are supported,
+	// as well as references to complete key presets (e.g. "@otel"), see [Options.KeyPresets].
 	AllowedKeys []string
+	// Report the use of log keys inside particular groups that are not explicitly allowed for these groups.
+	// The map keys are group names, the values are allowed keys in the same format as in [Options.AllowedKeys].
+	// If a group is listed here, [Options.AllowedKeys] is not used for the keys inside this group.
+	GroupAllowedKeys map[string][]string
 	// Report the use of forbidden log keys.
+	// Besides exact keys, globs and regular expressions are supported, see [Options.AllowedKeys].
 	ForbiddenKeys []string
-	// Report log keys that do not match a particular naming case ("snake", "kebab", "camel", or "pascal").
+	// Report the use of deprecated log keys and suggest their replacements.
+	// The map keys are deprecated keys, the values are the keys to use instead (e.g. "uid": "user_id").
+	RenamedKeys map[string]string
+	// Report log keys that do not match a particular naming case ("snake", "kebab", "camel", "pascal", "dot", or "screaming-snake").
 	KeyNamingCase string
+	// Report log keys that do not match a particular regular expression, for conventions not covered by [Options.KeyNamingCase].
+	KeyNamingPattern string
+	// Initialisms to keep uppercased in camel and pascal cases (e.g. "ID" or "HTTP").
+	KeyNamingInitialisms []string
+	// Check each segment of dotted keys separately (e.g. "http.request_id" is valid in snake case).
+	KeyNamingSegments bool
+	// Log keys that are not checked for their naming case or pattern.
+	// Globs and regular expressions are supported, see [Options.AllowedKeys].
+	KeyNamingExceptions []string
+	// Report log keys without a namespace in particular packages.
+	// The map keys are package patterns (e.g. "example.com/billing/..."), the values are namespaces (e.g. "billing").
+	// A key has a namespace if it's prefixed with it (e.g. "billing.amount") or put inside a group with its name.
+	KeyNamespaces map[string]string
+	// Report log calls without the keys required in particular packages, functions, or levels.
+	// A key is present if it's passed to the call itself or to a With call on the same logger earlier in the function.
+	RequiredKeys []KeyRequirement
+	// Report log keys that are near-misses of the keys from bundled presets ("otel" or "ecs"),
+	// e.g. "http_method" instead of "http.request.method" from OpenTelemetry semantic conventions.
+	KeyPresets []string
+	// Report log keys with characters that may be rendered badly by the standard handlers or break log parsers,
+	// such as spaces, "=", quotes, control and non-ASCII characters, as well as empty keys and keys starting with a digit.
+	SafeKeys bool
+	// Report log keys that are used with values of different types, including across imported packages.
+	ConsistentKeyTypes bool
+	// Report log keys that are spelled differently but have the same words, including across imported packages.
+	ConsistentKeyNames bool
+	// Packages that declare log keys as constants, used to suggest fixes (e.g. "example.com/logkeys").
+	KeyPackages []string
+
+	// Report group names that do not match a particular naming case, see [Options.KeyNamingCase].
+	// If set, [Options.KeyNamingCase] is not used for group names.
+	GroupNamingCase string
+	// Report the use of group names that are not explicitly allowed.
+	// Globs and regular expressions are supported, see [Options.AllowedKeys].
+	// If set, [Options.AllowedKeys] is not used for group names.
+	AllowedGroups []string
+	// Report the use of forbidden group names.
+	// Globs and regular expressions are supported, see [Options.AllowedKeys].
+	ForbiddenGroups []string
+	// Report groups that are nested deeper than the given number of levels.
+	// The groups opened on the logger with WithGroup count too.
+	MaxGroupDepth int
+	// Report groups without attributes, which are ignored by handlers.
+	NoEmptyGroups bool
+
+	// Report non-constant levels in Log/LogAttrs calls, unless they come from a [slog.Leveler],
+	// and constant levels other than the slog.Level* constants and [Options.CustomLevels].
+	NamedLevels bool
+	// The full names of custom level constants allowed by [Options.NamedLevels], e.g. "example.com/logging.LevelTrace".
+	CustomLevels []string
+	// Report log calls of the levels that are not explicitly allowed in particular packages.
+	// The map keys are package patterns (e.g. "example.com/lib/..."), see [Options.KeyNamespaces].
+	// The values are allowed levels ("debug", "info", "warn", or "error").
+	AllowedLevels map[string][]string
+	// Report Debug-level log calls with arguments that need non-trivial computation (e.g. function calls or slice/map literals),
+	// unless they are inside an "if logger.Enabled(ctx, slog.LevelDebug)" block or the values implement [slog.LogValuer].
+	GuardedDebugArguments bool
+	// Report log calls that log an error which is then returned, as is or wrapped, in the same block.
+	NoLogAndReturn bool
+	// Globs for the full names of the functions that are allowed to log and return errors, e.g. "(*example.com/api.Server).ServeHTTP".
+	LogAndReturnExceptions []string
+	// Report log calls below the given level ("info", "warn", or "error") that log the error inside error-handling blocks,
+	// as well as Error-level log calls outside of such blocks that don't log any error.
+	ErrorLevel string
+	// The blocks considered error-handling by [Options.ErrorLevel]:
+	// "if" for "if err != nil { ... }", "else" for "if err == nil { ... } else { ... }",
+	// and "case" for "switch { case err != nil: ... }" (default "if").
+	ErrorBlocks []string
 
 	// Analyze custom functions in addition to the standard [log/slog] functions.
 	CustomFuncs []Func
@@ -72,12 +212,36 @@ const (
 	messageStyleCapitalized = "capitalized"
 )
 
+// Possible values for [Options.ArgumentOrder].
+const (
+	argumentOrderAlphabetical = "alphabetical"
+	argumentOrderSchema       = "schema"
+)
+
 // Possible values for [Options.KeyNamingCase].
 const (
 	keyNamingCaseSnake  = "snake"
 	keyNamingCaseKebab  = "kebab"
 	keyNamingCaseCamel  = "camel"
 	keyNamingCasePascal = "pascal"
+	keyNamingCaseDot    = "dot"
+
+	keyNamingCaseScreamingSnake = "screaming-snake"
+)
+
+// Possible values for [Options.ErrorBlocks].
+const (
+	errorBlockIf   = "if"
+	errorBlockElse = "else"
+	errorBlockCase = "case"
+)
+
+// Possible values for [KeyRequirement.Levels], [Options.AllowedLevels], and [Options.ErrorLevel].
+const (
+	levelDebug = "debug"
+	levelInfo  = "info"
+	levelWarn  = "warn"
+	levelError = "error"
 )
 
 var (
@@ -108,16 +272,159 @@ func (opts *Options) validate() error {
 		return fmt.Errorf("sloglint: Options.KeyValuePairsOnly and Options.AttributesOnly are %w", errIncompatible)
 	}
 
+	switch opts.ArgumentOrder {
+	case "", argumentOrderAlphabetical:
+	case argumentOrderSchema:
+		if len(opts.ArgumentOrderSchema) == 0 {
+			return fmt.Errorf("sloglint: Options.ArgumentOrderSchema has an %w: no keys", errInvalidValue)
+		}
+	default:
+		return fmt.Errorf("sloglint: Options.ArgumentOrder has an %w %q", errInvalidValue, opts.ArgumentOrder)
+	}
+
 	switch opts.KeyNamingCase {
-	case "", keyNamingCaseSnake, keyNamingCaseKebab, keyNamingCaseCamel, keyNamingCasePascal:
+	case "", keyNamingCaseSnake, keyNamingCaseKebab, keyNamingCaseCamel, keyNamingCasePascal, keyNamingCaseDot, keyNamingCaseScreamingSnake:
 	default:
 		return fmt.Errorf("sloglint: Options.KeyNamingCase has an %w %q", errInvalidValue, opts.KeyNamingCase)
 	}
 
+	switch opts.GroupNamingCase {
+	case "", keyNamingCaseSnake, keyNamingCaseKebab, keyNamingCaseCamel, keyNamingCasePascal, keyNamingCaseDot, keyNamingCaseScreamingSnake:
+	default:
+		return fmt.Errorf("sloglint: Options.GroupNamingCase has an %w %q", errInvalidValue, opts.GroupNamingCase)
+	}
+
+	if opts.MaxArguments < 0 {
+		return fmt.Errorf("sloglint: Options.MaxArguments has an %w %d", errInvalidValue, opts.MaxArguments)
+	}
+
+	if opts.MaxAttributes < 0 {
+		return fmt.Errorf("sloglint: Options.MaxAttributes has an %w %d", errInvalidValue, opts.MaxAttributes)
+	}
+
+	if opts.MaxGroupDepth < 0 {
+		return fmt.Errorf("sloglint: Options.MaxGroupDepth has an %w %d", errInvalidValue, opts.MaxGroupDepth)
+	}
+
+	switch opts.ErrorLevel {
+	case "", levelInfo, levelWarn, levelError:
+	default:
+		return fmt.Errorf("sloglint: Options.ErrorLevel has an %w %q", errInvalidValue, opts.ErrorLevel)
+	}
+
+	for _, block := range opts.ErrorBlocks {
+		switch block {
+		case errorBlockIf, errorBlockElse, errorBlockCase:
+		default:
+			return fmt.Errorf("sloglint: Options.ErrorBlocks has an %w %q", errInvalidValue, block)
+		}
+	}
+
+	for _, pattern := range opts.LogValuerTypes {
+		if _, err := regexp.Compile(keyPatternRegexp(pattern)); err != nil {
+			return fmt.Errorf("sloglint: Options.LogValuerTypes has an %w %q: %w", errInvalidValue, pattern, err)
+		}
+	}
+
+	for _, pattern := range opts.LogAndReturnExceptions {
+		if _, err := regexp.Compile(keyPatternRegexp(pattern)); err != nil {
+			return fmt.Errorf("sloglint: Options.LogAndReturnExceptions has an %w %q: %w", errInvalidValue, pattern, err)
+		}
+	}
+
+	for _, pattern := range slices.Sorted(maps.Keys(opts.AllowedLevels)) {
+		for _, level := range opts.AllowedLevels[pattern] {
+			switch level {
+			case levelDebug, levelInfo, levelWarn, levelError:
+			default:
+				return fmt.Errorf("sloglint: Options.AllowedLevels has an %w %q", errInvalidValue, level)
+			}
+		}
+	}
+
+	if opts.KeyNamingCase != "" && opts.KeyNamingPattern != "" {
+		return fmt.Errorf("sloglint: Options.KeyNamingCase and Options.KeyNamingPattern are %w", errIncompatible)
+	}
+
+	if _, err := regexp.Compile(opts.KeyNamingPattern); err != nil {
+		return fmt.Errorf("sloglint: Options.KeyNamingPattern has an %w %q: %w", errInvalidValue, opts.KeyNamingPattern, err)
+	}
+
+	for _, preset := range opts.KeyPresets {
+		if _, ok := keyPresetTitles[preset]; !ok {
+			return fmt.Errorf("sloglint: Options.KeyPresets has an %w %q", errInvalidValue, preset)
+		}
+	}
+
+	validatePatterns := func(name string, patterns []string) error {
+		for _, pattern := range patterns {
+			if !isKeyPattern(pattern) {
+				continue
+			}
+			if preset, ok := keyPresetRef(pattern); ok {
+				if !loadKeyPresets()[preset].complete {
+					return fmt.Errorf("sloglint: Options.%s has an %w %q: the preset is incomplete", name, errInvalidValue, pattern)
+				}
+				continue
+			}
+			if _, err := regexp.Compile(keyPatternRegexp(pattern)); err != nil {
+				return fmt.Errorf("sloglint: Options.%s has an %w %q: %w", name, errInvalidValue, pattern, err)
+			}
+		}
+		return nil
+	}
+
+	if err := validatePatterns("AllowedKeys", opts.AllowedKeys); err != nil {
+		return err
+	}
+	if err := validatePatterns("ForbiddenKeys", opts.ForbiddenKeys); err != nil {
+		return err
+	}
+	if err := validatePatterns("KeyNamingExceptions", opts.KeyNamingExceptions); err != nil {
+		return err
+	}
+	if err := validatePatterns("SensitiveKeys", opts.SensitiveKeys); err != nil {
+		return err
+	}
+	if err := validatePatterns("ArgumentOrderSchema", opts.ArgumentOrderSchema); err != nil {
+		return err
+	}
+	if err := validatePatterns("AllowedGroups", opts.AllowedGroups); err != nil {
+		return err
+	}
+	if err := validatePatterns("ForbiddenGroups", opts.ForbiddenGroups); err != nil {
+		return err
+	}
+	for _, group := range slices.Sorted(maps.Keys(opts.GroupAllowedKeys)) {
+		if err := validatePatterns("GroupAllowedKeys", opts.GroupAllowedKeys[group]); err != nil {
+			return err
+		}
+	}
+
+	for _, req := range opts.RequiredKeys {
+		if len(req.Keys) == 0 {
+			return fmt.Errorf("sloglint: Options.RequiredKeys has an %w: no keys", errInvalidValue)
+		}
+		for _, level := range req.Levels {
+			switch level {
+			case levelDebug, levelInfo, levelWarn, levelError:
+			default:
+				return fmt.Errorf("sloglint: Options.RequiredKeys has an %w %q", errInvalidValue, level)
+			}
+		}
+		for _, pattern := range req.Funcs {
+			if _, err := regexp.Compile(keyPatternRegexp(pattern)); err != nil {
+				return fmt.Errorf("sloglint: Options.RequiredKeys has an %w %q: %w", errInvalidValue, pattern, err)
+			}
+		}
+	}
+
 	return nil
 }
 
-func flags(opts *Options) flag.FlagSet {
+// flags creates the flags for the options.
+// The factsChanged callback is called when a flag that affects [factTypes] is set.
+func flags(opts *Options, factsChanged func()) flag.FlagSet {
 	fs := flag.NewFlagSet("sloglint", flag.ContinueOnError)
 
 	listVar := func(p *[]string, name, usage string) {
@@ -135,10 +442,128 @@ func flags(opts *Options) flag.FlagSet {
 	fs.BoolVar(&opts.KeyValuePairsOnly, "kv-only", opts.KeyValuePairsOnly, `report any use of attributes as function call arguments`)
 	fs.BoolVar(&opts.AttributesOnly, "attr-only", opts.AttributesOnly, `report any use of key-value pairs as function call arguments`)
 	fs.BoolVar(&opts.ArgumentsOnSeparateLines, "args-on-sep-lines", opts.ArgumentsOnSeparateLines, `report two or more arguments on the same line`)
+	fs.IntVar(&opts.MaxArguments, "max-args", opts.MaxArguments, `report calls with more than the given number of arguments`)
+	fs.IntVar(&opts.MaxAttributes, "max-attrs", opts.MaxAttributes, `report log calls that result in more than the given number of attributes, including nested and With ones`)
+	fs.StringVar(&opts.ErrorKey, "error-key", opts.ErrorKey, `report errors that are logged under a key other than the given one or as strings`)
+	fs.BoolVar(&opts.LogValuerReceivers, "log-valuer-receivers", opts.LogValuerReceivers, `report values of the types that implement slog.LogValuer only with a pointer receiver`)
+	listVar(&opts.LogValuerTypes, "log-valuer-types", `report values of particular types that don't implement slog.LogValuer`)
+	fs.BoolVar(&opts.SensitiveTypes, "sensitive-types", opts.SensitiveTypes, `report values of struct types that don't implement slog.LogValuer but have sensitive fields`)
+	listVar(&opts.SensitiveValues, "sensitive-values", `report values with identifiers that match particular patterns`)
+	listVar(&opts.SensitiveKeys, "sensitive-keys", `report values of the keys with sensitive names`)
+	listVar(&opts.RedactionFuncs, "redaction-funcs", `the full names of the functions that redact values`)
+	fs.Func("forbidden-value-types", `report values of forbidden types (format: "type[:suggestion]")`, func(s string) error {
+		typ, suggestion, _ := strings.Cut(s, ":")
+		if opts.ForbiddenValueTypes == nil {
+			opts.ForbiddenValueTypes = make(map[string]string)
+		}
+		opts.ForbiddenValueTypes[typ] = suggestion
+		return nil
+	})
+	fs.BoolVar(&opts.TypedAttributes, "typed-attrs", opts.TypedAttributes, `report slog.Any and slog.String calls that should be replaced with typed attribute constructors`)
+	fs.StringVar(&opts.ArgumentOrder, "arg-order", opts.ArgumentOrder, `report arguments that are not in a particular order by their keys ("alphabetical" or "schema")`)
+	listVar(&opts.ArgumentOrderSchema, "arg-order-schema", `the keys in the expected order, used by the "schema" argument order`)
+	fs.BoolVar(&opts.GroupsLast, "groups-last", opts.GroupsLast, `report groups that are followed by other arguments`)
 	fs.BoolVar(&opts.ConstantKeys, "const-keys", opts.ConstantKeys, `report the use of string literal as log keys`)
 	listVar(&opts.AllowedKeys, "allowed-keys", `report the use of log keys that are not explicitly allowed`)
+	fs.Func("group-allowed-keys", `report the use of log keys inside a group that are not explicitly allowed for this group (format: "group:key1,key2")`, func(s string) error {
+		group, keys, _ := strings.Cut(s, ":")
+		if opts.GroupAllowedKeys == nil {
+			opts.GroupAllowedKeys = make(map[string][]string)
+		}
+		opts.GroupAllowedKeys[group] = append(opts.GroupAllowedKeys[group], strings.Split(keys, ",")...)
+		return nil
+	})
 	listVar(&opts.ForbiddenKeys, "forbidden-keys", `report the use of forbidden log keys`)
-	fs.StringVar(&opts.KeyNamingCase, "key-naming-case", opts.KeyNamingCase, `report log keys that do not match a particular naming case ("snake", "kebab", "camel", or "pascal")`)
+	fs.Func("renamed-keys", `report the use of deprecated log keys and suggest their replacements (format: "old:new", comma-separated)`, func(s string) error {
+		if opts.RenamedKeys == nil {
+			opts.RenamedKeys = make(map[string]string)
+		}
+		for pair := range strings.SplitSeq(s, ",") {
+			oldKey, newKey, ok := strings.Cut(pair, ":")
+			if !ok {
+				return fmt.Errorf("invalid format %q", pair)
+			}
+			opts.RenamedKeys[oldKey] = newKey
+		}
+		return nil
+	})
+	fs.StringVar(&opts.KeyNamingCase, "key-naming-case", opts.KeyNamingCase, `report log keys that do not match a particular naming case ("snake", "kebab", "camel", "pascal", "dot", or "screaming-snake")`)
+	fs.StringVar(&opts.KeyNamingPattern, "key-naming-pattern", opts.KeyNamingPattern, `report log keys that do not match a particular regular expression`)
+	listVar(&opts.KeyNamingInitialisms, "key-naming-initialisms", `initialisms to keep uppercased in camel and pascal cases`)
+	fs.BoolVar(&opts.KeyNamingSegments, "key-naming-segments", opts.KeyNamingSegments, `check each segment of dotted keys separately`)
+	listVar(&opts.KeyNamingExceptions, "key-naming-exceptions", `log keys that are not checked for their naming case or pattern`)
+	fs.Func("key-namespaces", `report log keys without a namespace in particular packages (format: "pkg-pattern:namespace", comma-separated)`, func(s string) error {
+		if opts.KeyNamespaces == nil {
+			opts.KeyNamespaces = make(map[string]string)
+		}
+		for pair := range strings.SplitSeq(s, ",") {
+			pattern, namespace, ok := strings.Cut(pair, ":")
+			if !ok {
+				return fmt.Errorf("invalid format %q", pair)
+			}
+			opts.KeyNamespaces[pattern] = namespace
+		}
+		return nil
+	})
+	fs.Func("required-keys", `report log calls without the required keys (format: "key1,key2[;pkgs=...][;funcs=...][;levels=...][;http-handlers]")`, func(s string) error {
+		keys, rest, _ := strings.Cut(s, ";")
+		req := KeyRequirement{Keys: strings.Split(keys, ",")}
+		for part := range strings.SplitSeq(rest, ";") {
+			name, values, _ := strings.Cut(part, "=")
+			switch name {
+			case "":
+			case "pkgs":
+				req.Packages = strings.Split(values, ",")
+			case "funcs":
+				req.Funcs = strings.Split(values, ",")
+			case "levels":
+				req.Levels = strings.Split(values, ",")
+			case "http-handlers":
+				req.HTTPHandlers = true
+			default:
+				return fmt.Errorf("invalid format %q", part)
+			}
+		}
+		opts.RequiredKeys = append(opts.RequiredKeys, req)
+		return nil
+	})
+	listVar(&opts.KeyPresets, "key-presets", `report log keys that are near-misses of the keys from bundled presets ("otel" or "ecs")`)
+	fs.BoolVar(&opts.SafeKeys, "safe-keys", opts.SafeKeys, `report log keys with characters that may be rendered badly by the standard handlers or break log parsers`)
+	factsVar := func(p *bool, name, usage string) {
+		fs.BoolFunc(name, usage, func(s string) error {
+			v, err := strconv.ParseBool(s)
+			if err != nil {
+				return err
+			}
+			*p = v
+			factsChanged()
+			return nil
+		})
+	}
+
+	factsVar(&opts.ConsistentKeyTypes, "consistent-key-types", `report log keys that are used with values of different types, including across imported packages`)
+	factsVar(&opts.ConsistentKeyNames, "consistent-key-names", `report log keys that are spelled differently but have the same words, including across imported packages`)
+	listVar(&opts.KeyPackages, "key-pkgs", `packages that declare log keys as constants, used to suggest fixes`)
+	fs.StringVar(&opts.GroupNamingCase, "group-naming-case", opts.GroupNamingCase, `report group names that do not match a particular naming case ("snake", "kebab", "camel", "pascal", "dot", or "screaming-snake")`)
+	listVar(&opts.AllowedGroups, "allowed-groups", `report the use of group names that are not explicitly allowed`)
+	listVar(&opts.ForbiddenGroups, "forbidden-groups", `report the use of forbidden group names`)
+	fs.IntVar(&opts.MaxGroupDepth, "max-group-depth", opts.MaxGroupDepth, `report groups that are nested deeper than the given number of levels`)
+	fs.BoolVar(&opts.NoEmptyGroups, "no-empty-groups", opts.NoEmptyGroups, `report groups without attributes, which are ignored by handlers`)
+	fs.BoolVar(&opts.NamedLevels, "named-levels", opts.NamedLevels, `report non-constant levels in Log/LogAttrs calls, unless they come from a slog.Leveler, and unnamed constant levels`)
+	listVar(&opts.CustomLevels, "custom-levels", `the full names of custom level constants allowed by named-levels`)
+	fs.Func("allowed-levels", `report log calls of the levels that are not explicitly allowed in a package (format: "pkg-pattern:level1,level2")`, func(s string) error {
+		pattern, levels, _ := strings.Cut(s, ":")
+		if opts.AllowedLevels == nil {
+			opts.AllowedLevels = make(map[string][]string)
+		}
+		opts.AllowedLevels[pattern] = append(opts.AllowedLevels[pattern], strings.Split(levels, ",")...)
+		return nil
+	})
+	fs.BoolVar(&opts.GuardedDebugArguments, "guarded-debug-args", opts.GuardedDebugArguments, `report Debug-level log calls with expensive arguments that are not guarded by Enabled`)
+	fs.BoolVar(&opts.NoLogAndReturn, "no-log-and-return", opts.NoLogAndReturn, `report log calls that log an error which is then returned in the same block`)
+	listVar(&opts.LogAndReturnExceptions, "log-and-return-exceptions", `the functions that are allowed to log and return errors`)
+	fs.StringVar(&opts.ErrorLevel, "error-level", opts.ErrorLevel, `report log calls below the given level ("info", "warn", or "error") that log the error inside error-handling blocks`)
+	listVar(&opts.ErrorBlocks, "error-blocks", `the blocks considered error-handling by error-level ("if", "else", or "case")`)
 
 	fs.Func("fn", `analyze a custom function (format: "full-name:msg-pos:args-pos")`, func(s string) error {
 		name, rest, _ := strings.Cut(s, ":")
@@ -13,8 +13,33 @@ func TestOptions_validate(t *testing.T) {
 		"invalid NoGlobalLogger":           {Options{NoGlobalLogger: "-"}, errInvalidValue},
 		"invalid ContextOnly":              {Options{ContextOnly: "-"}, errInvalidValue},
 		"invalid MessageStyle":             {Options{MessageStyle: "-"}, errInvalidValue},
+		"invalid MaxArguments":             {Options{MaxArguments: -1}, errInvalidValue},
+		"invalid MaxAttributes":            {Options{MaxAttributes: -1}, errInvalidValue},
+		"invalid ArgumentOrder":            {Options{ArgumentOrder: "-"}, errInvalidValue},
+		"empty ArgumentOrderSchema":        {Options{ArgumentOrder: argumentOrderSchema}, errInvalidValue},
+		"invalid ArgumentOrderSchema":      {Options{ArgumentOrder: argumentOrderSchema, ArgumentOrderSchema: []string{"^("}}, errInvalidValue},
 		"invalid KeyNamingCase":            {Options{KeyNamingCase: "-"}, errInvalidValue},
+		"invalid KeyNamingPattern":         {Options{KeyNamingPattern: "("}, errInvalidValue},
+		"invalid KeyNamingExceptions":      {Options{KeyNamingExceptions: []string{"^("}}, errInvalidValue},
+		"invalid GroupNamingCase":          {Options{GroupNamingCase: "-"}, errInvalidValue},
+		"invalid MaxGroupDepth":            {Options{MaxGroupDepth: -1}, errInvalidValue},
+		"invalid AllowedGroups":            {Options{AllowedGroups: []string{"^("}}, errInvalidValue},
+		"invalid ForbiddenGroups":          {Options{ForbiddenGroups: []string{"^("}}, errInvalidValue},
+		"invalid KeyPresets":               {Options{KeyPresets: []string{"-"}}, errInvalidValue},
+		"incomplete AllowedKeys preset":    {Options{AllowedKeys: []string{"@" + keyPresetECS}}, errInvalidValue},
+		"invalid AllowedKeys":              {Options{AllowedKeys: []string{"^("}}, errInvalidValue},
+		"invalid ForbiddenKeys":            {Options{ForbiddenKeys: []string{"^("}}, errInvalidValue},
+		"invalid GroupAllowedKeys":         {Options{GroupAllowedKeys: map[string][]string{"group": {"^("}}}, errInvalidValue},
+		"invalid RequiredKeys":             {Options{RequiredKeys: []KeyRequirement{{Keys: []string{"foo"}, Levels: []string{"-"}}}}, errInvalidValue},
+		"empty RequiredKeys":               {Options{RequiredKeys: []KeyRequirement{{Levels: []string{levelError}}}}, errInvalidValue},
+		"invalid SensitiveKeys":            {Options{SensitiveKeys: []string{"^("}}, errInvalidValue},
+		"invalid LogValuerTypes":           {Options{LogValuerTypes: []string{"^("}}, errInvalidValue},
+		"invalid LogAndReturnExceptions":   {Options{LogAndReturnExceptions: []string{"^("}}, errInvalidValue},
+		"invalid AllowedLevels":            {Options{AllowedLevels: map[string][]string{"example.com/lib/...": {"-"}}}, errInvalidValue},
+		"invalid ErrorLevel":               {Options{ErrorLevel: "-"}, errInvalidValue},
+		"invalid ErrorBlocks":              {Options{ErrorBlocks: []string{"-"}}, errInvalidValue},
 		"KeyValuePairsOnly+AttributesOnly": {Options{KeyValuePairsOnly: true, AttributesOnly: true}, errIncompatible},
+		"KeyNamingCase+KeyNamingPattern":   {Options{KeyNamingCase: keyNamingCaseSnake, KeyNamingPattern: "^[a-z]+$"}, errIncompatible},
 	}
 
 	for name, test := range tests {
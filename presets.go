@@ -0,0 +1,66 @@
+package sloglint
+
+import (
+	"bufio"
+	"embed"
+	"strings"
+	"sync"
+)
+
+//go:generate go run presets/generate.go
+
+//go:embed presets/*.txt
+var presetFiles embed.FS
+
+// keyPreset is a bundled list of well-known log keys, see [Options.KeyPresets].
+type keyPreset struct {
+	title      string
+	keys       []string
+	normalized map[string]string // Normalized keys and deprecated names -> keys.
+	complete   bool              // Whether the preset is generated from an upstream release, so it can be used as allowed keys.
+}
+
+// Possible values for [Options.KeyPresets].
+const (
+	keyPresetOTel = "otel"
+	keyPresetECS  = "ecs"
+)
+
+var keyPresetTitles = map[string]string{
+	keyPresetOTel: "OpenTelemetry semantic conventions",
+	keyPresetECS:  "Elastic Common Schema",
+}
+
+var loadKeyPresets = sync.OnceValue(func() map[string]*keyPreset {
+	presets := make(map[string]*keyPreset, len(keyPresetTitles))
+
+	for name, title := range keyPresetTitles {
+		data, err := presetFiles.ReadFile("presets/" + name + ".txt")
+		if err != nil {
+			panic("unreachable") // The presets are embedded.
+		}
+
+		preset := &keyPreset{title: title, normalized: make(map[string]string)}
+		scanner := bufio.NewScanner(strings.NewReader(string(data)))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(line, "# Generated by ") {
+				preset.complete = true
+			}
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			fields := strings.Fields(line)
+			preset.keys = append(preset.keys, fields[0])
+			for _, field := range fields {
+				if _, ok := preset.normalized[normalizeKey(field)]; !ok {
+					preset.normalized[normalizeKey(field)] = fields[0]
+				}
+			}
+		}
+
+		presets[name] = preset
+	}
+
+	return presets
+})
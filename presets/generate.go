@@ -0,0 +1,150 @@
+//go:build ignore
+
+// This program regenerates the key presets from pinned upstream releases.
+// Run it with "go generate" from the root of the repository; the presets to regenerate can be passed as arguments.
+// The deprecated names that follow the keys are maintained by hand and kept as is.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// The OpenTelemetry attributes are taken from the Go semantic conventions package,
+	// which is generated from the semantic conventions registry.
+	otelModule  = "go.opentelemetry.io/otel"
+	otelVersion = "v1.44.0"
+	otelSemconv = "v1.41.0"
+
+	// The ECS fields are taken from the flattened field definitions of the release.
+	ecsVersion = "v8.17.0"
+	ecsURL     = "https://raw.githubusercontent.com/elastic/ecs/" + ecsVersion + "/generated/ecs/ecs_flat.yml"
+)
+
+var generators = map[string]func() (header string, keys []string, err error){
+	"otel": otelKeys,
+	"ecs":  ecsKeys,
+}
+
+func main() {
+	log.SetFlags(0)
+
+	names := os.Args[1:]
+	if len(names) == 0 {
+		names = []string{"otel", "ecs"}
+	}
+
+	for _, name := range names {
+		generate, ok := generators[name]
+		if !ok {
+			log.Fatalf("unknown preset %q", name)
+		}
+		header, keys, err := generate()
+		if err != nil {
+			log.Fatalf("generating %s: %v", name, err)
+		}
+		if err := write(filepath.Join("presets", name+".txt"), header, keys); err != nil {
+			log.Fatalf("writing %s: %v", name, err)
+		}
+	}
+}
+
+func otelKeys() (string, []string, error) {
+	out, err := exec.Command("go", "mod", "download", "-json", otelModule+"@"+otelVersion).Output()
+	if err != nil {
+		return "", nil, fmt.Errorf("downloading %s: %w", otelModule, err)
+	}
+	var module struct{ Dir string }
+	if err := json.Unmarshal(out, &module); err != nil {
+		return "", nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(module.Dir, "semconv", otelSemconv, "attribute_group.go"))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var keys []string
+	for _, m := range regexp.MustCompile(`attribute\.Key\("([^"]+)"\)`).FindAllSubmatch(data, -1) {
+		keys = append(keys, string(m[1]))
+	}
+
+	header := fmt.Sprintf("# OpenTelemetry semantic conventions %s attributes, excluding the deprecated ones.\n"+
+		"# Generated by presets/generate.go from %s/semconv/%s (%s@%s).\n"+
+		"# Each line contains an attribute name, optionally followed by its deprecated names.\n",
+		otelSemconv, otelModule, otelSemconv, otelModule, otelVersion)
+
+	return header, keys, nil
+}
+
+func ecsKeys() (string, []string, error) {
+	resp, err := http.Get(ecsURL)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("fetching %s: %s", ecsURL, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The field names are the top-level keys of the document.
+	var keys []string
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" || line[0] == ' ' || line[0] == '#' || !strings.HasSuffix(line, ":") {
+			continue
+		}
+		keys = append(keys, strings.Trim(strings.TrimSuffix(line, ":"), `'"`))
+	}
+
+	header := fmt.Sprintf("# Elastic Common Schema %s fields.\n"+
+		"# Generated by presets/generate.go from %s.\n"+
+		"# Each line contains a field name, optionally followed by its deprecated names.\n",
+		ecsVersion, ecsURL)
+
+	return header, keys, nil
+}
+
+// write writes the keys to the preset file, keeping the deprecated names listed in the existing file.
+func write(path, header string, keys []string) error {
+	deprecated := make(map[string][]string)
+	if data, err := os.ReadFile(path); err == nil {
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			fields := strings.Fields(line)
+			deprecated[fields[0]] = fields[1:]
+		}
+	}
+
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, key := range keys {
+		buf.WriteString(strings.Join(append([]string{key}, deprecated[key]...), " "))
+		buf.WriteString("\n")
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
@@ -0,0 +1,13 @@
+package allowed_groups
+
+import "log/slog"
+
+func _(logger *slog.Logger) {
+	slog.Info("msg", slog.Group("http", "user_id", 1))
+	slog.Info("msg", slog.Group("user_info", "user_id", 1))
+	logger.WithGroup("http").Info("msg")
+
+	slog.Info("msg", slog.Group("request", "user_id", 1))            // want `the "request" group is not allowed and should not be used`
+	slog.Info("msg", slog.GroupAttrs("internal", slog.Int("id", 1))) // want `the "internal" group is forbidden and should not be used` `the "id" key is not allowed and should not be used`
+	logger.WithGroup("request")                                      // want `the "request" group is not allowed and should not be used`
+}
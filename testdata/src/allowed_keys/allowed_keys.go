@@ -1,10 +1,15 @@
 package allowed_keys
 
-import "log/slog"
+import (
+	"log/slog"
+
+	"allowed_keys/keys"
+)
 
 const (
 	fooKey = "foo"
 	barKey = "bar"
+	usrKey = "usr_id"
 )
 
 func _() {
@@ -12,9 +17,16 @@ func _() {
 	slog.Info("msg", fooKey, 1)
 	slog.Info("msg", slog.Int("foo", 1))
 	slog.Info("msg", slog.Int(fooKey, 1))
+	slog.Info("msg", keys.UserID, 1)
+	slog.Info("msg", "@timestamp", 1)
 
 	slog.Info("msg", "bar", 1)            // want `"bar" key is not allowed and should not be used`
 	slog.Info("msg", barKey, 1)           // want `"bar" key is not allowed and should not be used`
 	slog.Info("msg", slog.Int("bar", 1))  // want `"bar" key is not allowed and should not be used`
 	slog.Info("msg", slog.Int(barKey, 1)) // want `"bar" key is not allowed and should not be used`
+
+	slog.Info("msg", "usr_id", 1)           // want `the "usr_id" key is not allowed; did you mean "user_id"\?`
+	slog.Info("msg", usrKey, 1)             // want `the "usr_id" key is not allowed; did you mean "user_id"\?`
+	slog.Info("msg", slog.Int("usr_id", 1)) // want `the "usr_id" key is not allowed; did you mean "user_id"\?`
+	slog.Info("msg", slog.Attr{Key: "fo"})  // want `the "fo" key is not allowed; did you mean "foo"\?`
 }
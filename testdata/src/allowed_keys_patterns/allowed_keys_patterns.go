@@ -0,0 +1,19 @@
+package allowed_keys_patterns
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "user_id", 1)
+	slog.Info("msg", "http.method", "GET")
+	slog.Info("msg", slog.String("x_trace", "foo"))
+	slog.Info("msg", slog.Group("http", "method", "GET", slog.Int("status", 200)))
+	slog.Info("msg", slog.Group("http", slog.Group("user", "user_id", 1)))
+
+	slog.Info("msg", "http_method", "GET")                      // want `the "http_method" key is not allowed and should not be used`
+	slog.Info("msg", slog.String("trace_x", "foo"))             // want `the "trace_x" key is not allowed and should not be used`
+	slog.Info("msg", slog.Group("http", "user_id", 1))          // want `the "user_id" key is not allowed and should not be used`
+	slog.Info("msg", slog.Group("http", slog.Int("code", 200))) // want `the "code" key is not allowed and should not be used`
+	slog.Info("msg", slog.Group("http", slog.Attr{Key: "url"})) // want `the "url" key is not allowed and should not be used`
+	slog.Info("msg", slog.Group("user", "method", "GET"))       // want `the "method" key is not allowed and should not be used`
+	slog.Info("msg", slog.Group("web", "method", "GET"))        // want `the "web" key is not allowed and should not be used` `the "method" key is not allowed and should not be used`
+}
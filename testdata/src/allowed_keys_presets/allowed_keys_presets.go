@@ -0,0 +1,13 @@
+package allowed_keys_presets
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "http.request.method", "GET")
+	slog.Info("msg", "user.id", 1)
+	slog.Info("msg", "gen_ai.request.model", "x")
+	slog.Info("msg", "foo", 1)
+
+	slog.Info("msg", "bar", 1)      // want `the "bar" key is not allowed and should not be used`
+	slog.Info("msg", "user.idd", 1) // want `the "user.idd" key is not allowed; did you mean "user.id"\?`
+}
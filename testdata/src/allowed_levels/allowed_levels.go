@@ -0,0 +1,11 @@
+package allowed_levels
+
+import (
+	"log/slog"
+
+	_ "allowed_levels/lib"
+)
+
+func _() {
+	slog.Error("msg")
+}
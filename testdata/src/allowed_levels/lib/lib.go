@@ -0,0 +1,15 @@
+package lib
+
+import (
+	"context"
+	"log/slog"
+)
+
+func _(ctx context.Context) {
+	slog.Debug("msg")
+	slog.Warn("msg")
+	slog.Error("msg")                       // want `the Error level is not allowed in this package`
+	slog.ErrorContext(ctx, "msg")           // want `the Error level is not allowed in this package`
+	slog.Log(ctx, slog.LevelError+4, "msg") // want `the Error level is not allowed in this package`
+	slog.Log(ctx, slog.LevelInfo, "msg")
+}
@@ -0,0 +1,22 @@
+package arg_order_alphabetical
+
+import "log/slog"
+
+const fooKey = "foo"
+
+func _(attr slog.Attr, args []any) {
+	slog.Info("msg", "bar", 1, "foo", 2)
+	slog.Info("msg", "foo", 1, "bar", 2)                     // want `the "bar" argument should go before "foo"`
+	slog.Info("msg", fooKey, 1, "bar", 2)                    // want `the "bar" argument should go before "foo"`
+	slog.Info("msg", slog.Int("foo", 1), slog.Int("bar", 2)) // want `the "bar" argument should go before "foo"`
+	slog.Info("msg", slog.Group("a", "foo", 1), slog.Int("bar", 2))
+	slog.Info("msg", slog.Group("a", "foo", 1, "bar", 2), slog.Int("z", 3)) // want `the "bar" argument should go before "foo"`
+	slog.Info("msg", "foo", 1, attr)
+	slog.Info("msg", args...)
+	slog.With("c", 1, "b", 2, "a", 3) // want `the "a" argument should go before "c"`
+
+	slog.Info("msg",
+		"foo", 1, // want `the "bar" argument should go before "foo"`
+		"bar", 2,
+	)
+}
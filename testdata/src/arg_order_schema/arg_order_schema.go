@@ -0,0 +1,13 @@
+package arg_order_schema
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "request_id", 1, "foo", 2, "bar", 3, "error", 4)
+	slog.Info("msg", "foo", 1, "request_id", 2)             // want `the "request_id" argument should go before "foo"`
+	slog.Info("msg", "error", 1, "foo", 2)                  // want `the "foo" argument should go before "error"`
+	slog.Info("msg", "http.method", 1, "request_id", 2)     // want `the "request_id" argument should go before "http.method"`
+	slog.Info("msg", "foo", 1, "http.method", 2)            // want `the "http.method" argument should go before "foo"`
+	slog.Info("msg", slog.Group("g", "foo", 1), "error", 2) // want `the "error" argument should go before "g"`
+	slog.Info("msg", slog.Group("g", "foo", 1), "bar", 2)   // want `the "bar" argument should go before "g"`
+}
@@ -0,0 +1,51 @@
+package error_key
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"error_key/keys"
+)
+
+const (
+	errorKey = "error"
+	errKey   = "err"
+)
+
+type myError struct{}
+
+func (*myError) Error() string { return "" }
+
+func f() error { return nil }
+
+func _(ctx context.Context, logger *slog.Logger) {
+	err := errors.New("")
+	slog.Info("msg", "err", err)
+	slog.Info("msg", "error", err)                        // want `the error should be logged under the "err" key`
+	slog.Info("msg", errorKey, err)                       // want `the error should be logged under the "err" key`
+	slog.Info("msg", keys.Error, err)                     // want `the error should be logged under the "err" key`
+	slog.Info("msg", "e", &myError{})                     // want `the error should be logged under the "err" key`
+	slog.Info("msg", slog.Any("error", err))              // want `the error should be logged under the "err" key`
+	slog.Info("msg", "err", err.Error())                  // want `the error should be logged as is, not as a string`
+	slog.Info("msg", "error", err.Error())                // want `the error should be logged as is, not as a string`
+	slog.Info("msg", slog.String("error", err.Error()))   // want `the error should be logged as is, not as a string`
+	slog.Info("msg", slog.Group("g", slog.Any("e", err))) // want `the error should be logged under the "err" key`
+	slog.Info("msg", "error", nil)
+	slog.Info("msg", "error", "something went wrong")
+
+	if err := f(); err != nil {
+		slog.Error("msg") // want `the log call should include the error under the "err" key`
+		slog.Error("msg", "err", err)
+		slog.ErrorContext(ctx, "msg", slog.Any("err", err))
+		logger.With("err", err).Error("msg")
+		slog.Log(ctx, slog.LevelError, "msg") // want `the log call should include the error under the "err" key`
+		slog.Warn("msg")
+		func() {
+			slog.Error("msg")
+		}()
+	} else {
+		slog.Error("msg")
+	}
+	slog.Error("msg")
+}
@@ -0,0 +1,3 @@
+package keys
+
+const Error = "error"
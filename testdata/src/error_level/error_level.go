@@ -0,0 +1,54 @@
+package error_level
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+func f() error { return nil }
+
+func logDebug(msg string, args ...any) {}
+
+func logErrorInfo(msg string, args ...any) {}
+
+func logInformation(msg string, args ...any) {}
+
+func _(ctx context.Context) {
+	err := f()
+	if err != nil {
+		slog.Debug("msg", "err", err)                      // want `errors should be logged at the Warn level or higher`
+		slog.InfoContext(ctx, "msg", slog.Any("err", err)) // want `errors should be logged at the Warn level or higher`
+		slog.Info("msg", "err", err.Error())               // want `errors should be logged at the Warn level or higher`
+		slog.Log(ctx, slog.LevelInfo+2, "msg", "err", err) // want `errors should be logged at the Warn level or higher`
+		logDebug("msg", "err", err)                        // want `errors should be logged at the Warn level or higher`
+		slog.Info("msg")
+		logInformation("msg", "err", err)
+		slog.Warn("msg", "err", err)
+		slog.Error("msg", "err", err)
+		slog.Log(ctx, slog.LevelWarn, "msg", "err", err)
+		func() {
+			slog.Info("msg", "err", err)
+		}()
+	}
+
+	if err := f(); err == nil {
+		slog.Info("msg")
+	} else {
+		slog.Info("msg", "err", err) // want `errors should be logged at the Warn level or higher`
+	}
+
+	switch {
+	case err != nil:
+		slog.Info("msg", "err", err) // want `errors should be logged at the Warn level or higher`
+	default:
+		slog.Info("msg", "err", err)
+	}
+
+	slog.Error("msg") // want `Error-level log calls should include an error`
+	slog.Error("msg", "err", errors.New(""))
+	slog.Error("msg", slog.Any("err", err))
+	slog.Log(ctx, slog.LevelError, "msg") // want `Error-level log calls should include an error`
+	logErrorInfo("msg")                   // want `Error-level log calls should include an error`
+	slog.Info("msg")
+}
@@ -17,4 +17,9 @@ func _() {
 	slog.Info("msg", barKey, 1)           // want `"bar" key is forbidden and should not be used`
 	slog.Info("msg", slog.Int("bar", 1))  // want `"bar" key is forbidden and should not be used`
 	slog.Info("msg", slog.Int(barKey, 1)) // want `"bar" key is forbidden and should not be used`
+
+	slog.Info("msg", "api_secret", 1)      // want `"api_secret" key is forbidden and should not be used`
+	slog.Info("msg", "passwd", 1)          // want `"passwd" key is forbidden and should not be used`
+	slog.Info("msg", "secret_password", 1) // want `"secret_password" key is forbidden and should not be used`
+	slog.Info("msg", "secret", 1)
 }
@@ -0,0 +1,22 @@
+package forbidden_value_types
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+func _(ctx context.Context, r *http.Request, db *sql.DB, b []byte, raw json.RawMessage, ch chan int, fn func(), logger *slog.Logger) {
+	slog.Info("msg", "ctx", ctx)   // want `values of the context.Context type should not be logged`
+	slog.Info("msg", "request", r) // want `values of the \*net/http.Request type should not be logged, log r.URL.Path instead`
+	slog.Info("msg", "path", r.URL.Path)
+	slog.Info("msg", slog.Any("db", db)) // want `values of the \*database/sql.DB type should not be logged`
+	slog.Info("msg", "body", b)          // want `values of the \[\]byte type should not be logged, log string\(b\) instead`
+	slog.Info("msg", "raw", raw)
+	slog.Info("msg", "ch", ch)                    // want `values of the chan type should not be logged`
+	slog.Info("msg", "fn", fn)                    // want `values of the func type should not be logged`
+	slog.With("logger", logger)                   // want `values of the \*log/slog.Logger type should not be logged`
+	slog.Info("msg", slog.Group("g", "ctx", ctx)) // want `values of the context.Context type should not be logged`
+}
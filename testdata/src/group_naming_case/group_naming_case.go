@@ -0,0 +1,16 @@
+package group_naming_case
+
+import "log/slog"
+
+const groupKey = "httpRequest"
+
+func _(logger *slog.Logger) {
+	slog.Info("msg", slog.Group("http_request", "requestID", 1))
+	slog.Info("msg", slog.GroupAttrs("http_request", slog.Int("requestID", 1)))
+	logger.WithGroup("http_request").Info("msg")
+
+	slog.Info("msg", slog.Group("httpRequest", "requestID", 1))           // want `group names should be written in snake_case`
+	slog.Info("msg", slog.GroupAttrs(groupKey, slog.Int("requestID", 1))) // want `group names should be written in snake_case`
+	logger.WithGroup("http-request").Info("msg")                          // want `group names should be written in snake_case`
+	slog.Info("msg", slog.Group("http_request", "request_id", 1))         // want `keys should be written in camelCase`
+}
@@ -0,0 +1,50 @@
+package guarded_debug_args
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+type user struct{}
+
+func (user) LogValue() slog.Value { return slog.Value{} }
+
+func newUser() user { return user{} }
+
+func _(ctx context.Context, logger *slog.Logger, h slog.Handler, s []int, n int64) {
+	slog.Debug("msg", "n", n, "len", len(s), "i", int(n))
+	slog.Debug("msg", "s", fmt.Sprintf("%d", n))                   // want `expensive arguments of Debug-level log calls should be guarded by Enabled`
+	slog.Debug("msg", slog.Group("g", slog.Any("s", []int{1, 2}))) // want `expensive arguments of Debug-level log calls should be guarded by Enabled`
+	slog.Debug("msg", "u", newUser())
+	slog.Debug("msg", "f", func() string { return fmt.Sprint(n) })
+	slog.Info("msg", "s", fmt.Sprintf("%d", n))
+	slog.DebugContext(ctx, "msg", "json", json.Marshal)
+	slog.DebugContext(ctx, "msg",
+		"m", map[string]int{"a": 1}, // want `expensive arguments of Debug-level log calls should be guarded by Enabled`
+	)
+	logger.DebugContext(ctx, "msg",
+		"s", fmt.Sprint(n), // want `expensive arguments of Debug-level log calls should be guarded by Enabled`
+	)
+	slog.Log(ctx, slog.LevelDebug, "msg",
+		"s", append(s, 1), // want `expensive arguments of Debug-level log calls should be guarded by Enabled`
+	)
+
+	if logger.Enabled(ctx, slog.LevelDebug) {
+		logger.Debug("msg", "s", fmt.Sprint(n))
+	}
+	if h.Enabled(ctx, slog.LevelDebug) {
+		slog.Debug("msg", "s", fmt.Sprint(n))
+	}
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		logger.DebugContext(ctx, "msg",
+			"s", fmt.Sprint(n), // want `expensive arguments of Debug-level log calls should be guarded by Enabled`
+		)
+	}
+	if logger.Enabled(ctx, slog.LevelError) {
+		logger.DebugContext(ctx, "msg",
+			"s", fmt.Sprint(n), // want `expensive arguments of Debug-level log calls should be guarded by Enabled`
+		)
+	}
+}
@@ -0,0 +1,29 @@
+package key_names // want package:`keyNames\(11\)`
+
+import (
+	"log/slog"
+
+	"key_names/keys"
+	_ "key_names/users"
+)
+
+const userKey = "userID"
+
+func _() {
+	slog.Info("msg", "requestID", 1)
+	slog.Info("msg", "requestID", 2)
+	slog.Info("msg", slog.Int("status", 200))
+	slog.Info("msg", slog.Int("status", 201))
+	slog.Info("msg", slog.Group("req", "request_id", 1))
+	slog.Info("msg", slog.Group("req", "request_id", 2))
+	slog.Info("msg", slog.Group("userId", "id", 1))
+
+	slog.Info("msg", "userId", 1)                       // want `the "userId" key should be spelled as "user_id" to match its other uses`
+	slog.Info("msg", "user-ID", 1)                      // want `the "user-ID" key should be spelled as "user_id" to match its other uses`
+	slog.Info("msg", slog.Int("userid", 1))             // want `the "userid" key should be spelled as "user_id" to match its other uses`
+	slog.Info("msg", keys.UserID, 1)                    // want `the "userId" key should be spelled as "user_id" to match its other uses`
+	slog.Info("msg", userKey, 1)                        // want `the "userID" key should be spelled as "user_id" to match its other uses`
+	slog.Info("msg", "request_id", 1)                   // want `the "request_id" key should be spelled as "requestID" to match its other uses`
+	slog.Info("msg", slog.Attr{Key: "Status"})          // want `the "Status" key should be spelled as "status" to match its other uses`
+	slog.Info("msg", slog.Group("req", "requestID", 3)) // want `the "requestID" key should be spelled as "request_id" to match its other uses`
+}
@@ -0,0 +1,3 @@
+package keys
+
+const UserID = "userId"
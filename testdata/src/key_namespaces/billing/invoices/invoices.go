@@ -0,0 +1,24 @@
+package invoices
+
+import "log/slog"
+
+const amountKey = "billing.amount"
+
+func _(logger *slog.Logger) {
+	slog.Info("msg", "billing.invoice_id", 1)
+	slog.Info("msg", amountKey, 1)
+	slog.Info("msg", slog.Group("billing", "invoice_id", 1))
+	slog.Info("msg", slog.Group("billing", slog.Group("customer", slog.Int("id", 1))))
+	slog.Info("msg", slog.GroupAttrs("billing", slog.Int("invoice_id", 1)))
+	logger.WithGroup("billing").Info("msg", "amount", 1)
+	logger.WithGroup("billing").With("amount", 1).Info("msg", slog.Group("customer", "id", 1))
+
+	billing := logger.WithGroup("billing")
+	billing.Info("msg", "amount", 1)
+
+	slog.Info("msg", "invoice_id", 1)                                  // want `the "invoice_id" key should be prefixed with "billing." or put inside the "billing" group`
+	slog.Info("msg", slog.Int("billing_amount", 1))                    // want `the "billing_amount" key should be prefixed with "billing." or put inside the "billing" group`
+	slog.Info("msg", slog.Group("customer", "id", 1))                  // want `the "customer" key should be prefixed with "billing." or put inside the "billing" group` `the "id" key should be prefixed with "billing." or put inside the "billing" group`
+	slog.Info("msg", slog.Attr{Key: "total", Value: slog.IntValue(1)}) // want `the "total" key should be prefixed with "billing." or put inside the "billing" group`
+	logger.WithGroup("customer").Info("msg", "id", 1)                  // want `the "customer" key should be prefixed with "billing." or put inside the "billing" group` `the "id" key should be prefixed with "billing." or put inside the "billing" group`
+}
@@ -0,0 +1,11 @@
+package key_namespaces
+
+import (
+	"log/slog"
+
+	_ "key_namespaces/billing/invoices"
+)
+
+func _() {
+	slog.Info("msg", "invoice_id", 1)
+}
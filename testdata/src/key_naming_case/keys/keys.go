@@ -0,0 +1,3 @@
+package keys
+
+const FooBar = "foo-bar"
@@ -0,0 +1,3 @@
+package key_naming_case
+
+const pascalKey = "FooBar"
@@ -0,0 +1,14 @@
+package key_naming_case_camel
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "userID", 1)
+	slog.Info("msg", "httpStatus", 200)
+	slog.Info("msg", "legacy_key", 1)
+
+	slog.Info("msg", "userId", 1)               // want `keys should be written in camelCase`
+	slog.Info("msg", "HTTPStatus", 200)         // want `keys should be written in camelCase`
+	slog.Info("msg", slog.Int("user_id", 1))    // want `keys should be written in camelCase`
+	slog.Info("msg", slog.Int("request-id", 1)) // want `keys should be written in camelCase`
+}
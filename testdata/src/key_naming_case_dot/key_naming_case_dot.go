@@ -0,0 +1,12 @@
+package key_naming_case_dot
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "http.method", "GET")
+	slog.Info("msg", "user.id", 1)
+
+	slog.Info("msg", "http_method", "GET")       // want `keys should be written in dot.case`
+	slog.Info("msg", slog.Int("userID", 1))      // want `keys should be written in dot.case`
+	slog.Info("msg", slog.Int("http.Status", 1)) // want `keys should be written in dot.case`
+}
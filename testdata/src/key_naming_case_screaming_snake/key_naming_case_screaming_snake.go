@@ -0,0 +1,11 @@
+package key_naming_case_screaming_snake
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "USER_ID", 1)
+
+	slog.Info("msg", "user_id", 1)           // want `keys should be written in SCREAMING_SNAKE_CASE`
+	slog.Info("msg", slog.Int("userID", 1))  // want `keys should be written in SCREAMING_SNAKE_CASE`
+	slog.Info("msg", slog.Int("USER-ID", 1)) // want `keys should be written in SCREAMING_SNAKE_CASE`
+}
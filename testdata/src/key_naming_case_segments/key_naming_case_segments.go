@@ -0,0 +1,11 @@
+package key_naming_case_segments
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "http.request_id", 1)
+	slog.Info("msg", "user_id", 1)
+
+	slog.Info("msg", "http.requestId", 1)            // want `keys should be written in snake_case`
+	slog.Info("msg", slog.Int("HTTP.request_id", 1)) // want `keys should be written in snake_case`
+}
@@ -0,0 +1,14 @@
+package key_naming_pattern
+
+import "log/slog"
+
+const userKey = "user.id"
+
+func _() {
+	slog.Info("msg", "http.method", "GET")
+	slog.Info("msg", userKey, 1)
+	slog.Info("msg", "X-Request-ID", 1)
+
+	slog.Info("msg", "http_method", "GET")  // want `keys should match the "\^\[a-z.\]\+\$" pattern`
+	slog.Info("msg", slog.Int("userID", 1)) // want `keys should match the "\^\[a-z.\]\+\$" pattern`
+}
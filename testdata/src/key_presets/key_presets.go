@@ -0,0 +1,18 @@
+package key_presets
+
+import "log/slog"
+
+const methodKey = "httpMethod"
+
+func _() {
+	slog.Info("msg", "http.request.method", "GET")
+	slog.Info("msg", "user.id", 1)
+	slog.Info("msg", "trace.id", "foo")
+	slog.Info("msg", "foo", 1)
+
+	slog.Info("msg", "http_method", "GET")              // want `the "http_method" key should be "http.request.method" according to OpenTelemetry semantic conventions`
+	slog.Info("msg", methodKey, "GET")                  // want `the "httpMethod" key should be "http.request.method" according to OpenTelemetry semantic conventions`
+	slog.Info("msg", slog.Int("http.status_code", 200)) // want `the "http.status_code" key should be "http.response.status_code" according to OpenTelemetry semantic conventions`
+	slog.Info("msg", slog.Int("user_id", 1))            // want `the "user_id" key should be "user.id" according to OpenTelemetry semantic conventions`
+	slog.Info("msg", slog.String("traceID", "foo"))     // want `the "traceID" key should be "trace.id" according to Elastic Common Schema`
+}
@@ -0,0 +1,34 @@
+package key_types // want package:`keyTypes\(5\)`
+
+import (
+	"log/slog"
+	"time"
+
+	"key_types/keys"
+	_ "key_types/users"
+)
+
+const nameKey, idKey = "user_name", "user_id"
+
+type userID int64
+
+func _(id userID, v any) {
+	slog.Info("msg", "user_id", 1)
+	slog.Info("msg", "user_id", id)
+	slog.Info("msg", slog.Int64("user_id", 1))
+	slog.Info("msg", "user_name", "foo")
+	slog.Info("msg", slog.String("user_name", "foo"))
+	slog.Info("msg", "user_name", v)
+	slog.Info("msg", "duration", time.Second)
+	slog.Info("msg", slog.Group("user_id", "foo", 1))
+
+	slog.Info("msg", "user_id", "1")               // want `the "user_id" key has a value of type string, but integer was first used in key_types/users \(users.go:6\)`
+	slog.Info("msg", slog.String("user_id", "1"))  // want `the "user_id" key has a value of type string, but integer was first used in key_types/users \(users.go:6\)`
+	slog.Info("msg", slog.Bool("user_name", true)) // want `the "user_name" key has a value of type bool, but string was first used in key_types/users \(users.go:7\)`
+	slog.Info("msg", "duration", 1.5)              // want `the "duration" key has a value of type float, but time.Duration was first used at key_types.go:22`
+	slog.Info("msg", keys.UserID, "1")             // want `the "user_id" key has a value of type string, but integer was first used in key_types/users \(users.go:6\)`
+	slog.Info("msg", idKey, true)                  // want `the "user_id" key has a value of type bool, but integer was first used in key_types/users \(users.go:6\)`
+	slog.Info("msg", durationKey, "1s")            // want `the "duration" key has a value of type string, but time.Duration was first used at key_types.go:22`
+	slog.Info("msg", "foo", time.Now())
+	slog.Info("msg", slog.Group("user_id", "foo", "1")) // want `the "user_id.foo" key has a value of type string, but integer was first used at key_types.go:23`
+}
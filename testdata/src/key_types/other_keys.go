@@ -0,0 +1,3 @@
+package key_types
+
+const durationKey = "duration"
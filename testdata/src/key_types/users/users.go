@@ -0,0 +1,8 @@
+package users
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "user_id", 1)
+	slog.Info("msg", "user_name", "foo")
+}
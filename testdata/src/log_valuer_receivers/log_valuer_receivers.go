@@ -0,0 +1,27 @@
+package log_valuer_receivers
+
+import "log/slog"
+
+type User struct{ Password string }
+
+func (u *User) LogValue() slog.Value { return slog.Value{} }
+
+type Order struct{}
+
+func (Order) LogValue() slog.Value { return slog.Value{} }
+
+type wrapper struct{ user User }
+
+func newUser() User { return User{} }
+
+func _(u User, p *User, o Order, w wrapper) {
+	slog.Info("msg", "user", u) // want `the LogValue method of User has a pointer receiver and is not called for values, use a pointer instead`
+	slog.Info("msg", "user", p)
+	slog.Info("msg", "order", o)
+	slog.Info("msg", slog.Any("user", u))        // want `the LogValue method of User has a pointer receiver and is not called for values, use a pointer instead`
+	slog.Info("msg", "user", w.user)             // want `the LogValue method of User has a pointer receiver and is not called for values, use a pointer instead`
+	slog.Info("msg", "user", User{})             // want `the LogValue method of User has a pointer receiver and is not called for values, use a pointer instead`
+	slog.Info("msg", "user", newUser())          // want `the LogValue method of User has a pointer receiver and is not called for values, use a pointer instead`
+	slog.With("user", u)                         // want `the LogValue method of User has a pointer receiver and is not called for values, use a pointer instead`
+	slog.Info("msg", slog.Group("g", "user", u)) // want `the LogValue method of User has a pointer receiver and is not called for values, use a pointer instead`
+}
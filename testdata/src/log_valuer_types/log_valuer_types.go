@@ -0,0 +1,47 @@
+package log_valuer_types
+
+import (
+	"log/slog"
+
+	"log_valuer_types/models"
+)
+
+type credentials struct {
+	Login       string
+	AccessToken string
+}
+
+type session struct {
+	ID   int
+	Hash string `log:"-"`
+}
+
+type account struct {
+	credentials
+	Name string
+}
+
+type node struct {
+	*node
+	Name string
+}
+
+type safe struct{ Name string }
+
+type redacted struct{ Password string }
+
+func (redacted) LogValue() slog.Value { return slog.Value{} }
+
+func _(u models.User, p *models.User, us []models.User, o models.Order, c credentials, s *session, a account, n node, sf safe, r redacted) {
+	slog.Info("msg", "user", u)             // want `the models.User type should implement slog.LogValuer to be logged`
+	slog.Info("msg", "user", p)             // want `the models.User type should implement slog.LogValuer to be logged`
+	slog.Info("msg", slog.Any("users", us)) // want `the models.User type should implement slog.LogValuer to be logged`
+	slog.Info("msg", "order", o)
+	slog.Info("msg", "creds", c)   // want `the credentials type has the sensitive AccessToken field and should implement slog.LogValuer to be logged`
+	slog.Info("msg", "session", s) // want `the session type has the sensitive Hash field and should implement slog.LogValuer to be logged`
+	slog.Info("msg", "account", a) // want `the account type has the sensitive AccessToken field and should implement slog.LogValuer to be logged`
+	slog.Info("msg", "node", n)
+	slog.Info("msg", "safe", sf)
+	slog.Info("msg", "redacted", r)
+	slog.Info("msg", "name", u.Name)
+}
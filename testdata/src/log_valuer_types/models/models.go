@@ -0,0 +1,9 @@
+package models
+
+import "log/slog"
+
+type User struct{ Name string }
+
+type Order struct{ ID int }
+
+func (Order) LogValue() slog.Value { return slog.Value{} }
@@ -0,0 +1,19 @@
+package max_args
+
+import "log/slog"
+
+func _(logger *slog.Logger) {
+	slog.Info("msg", "a", 1, "b", 2)
+	slog.Info("msg", "a", 1, "b", 2, "c", 3)                               // want `slog.Info has 3 arguments, which is more than 2`
+	slog.Info("msg", slog.Int("a", 1), slog.Int("b", 2), slog.Int("c", 3)) // want `slog.Info has 3 arguments, which is more than 2`
+	slog.Info("msg", "a", 1, slog.Group("g", "b", 2, "c", 3, "d", 4))      // want `slog.Group has 3 arguments, which is more than 2` `the log call has 4 attributes, which is more than 3`
+	slog.With("a", 1, "b", 2, "c", 3)                                      // want `slog.With has 3 arguments, which is more than 2`
+	logger.With("a", 1, "b", 2, "c", 3)                                    // want `slog.Logger.With has 3 arguments, which is more than 2`
+
+	l := logger.With("a", 1)
+	l.Info("msg", "b", 2, "c", 3)
+	l = l.With("b", 2)
+	l.Info("msg", "c", 3, "d", 4)      // want `the log call has 4 attributes, which is more than 3`
+	l.With("c", 3).Info("msg", "d", 4) // want `the log call has 4 attributes, which is more than 3`
+	logger.Info("msg", "c", 3, "d", 4)
+}
@@ -0,0 +1,17 @@
+package max_group_depth
+
+import "log/slog"
+
+func _(logger *slog.Logger) {
+	slog.Info("msg", slog.Group("a", "foo", 1))
+	slog.Info("msg", slog.Group("a", slog.Group("b", "foo", 1)))
+	logger.WithGroup("a").WithGroup("b").Info("msg", "foo", 1)
+	logger.WithGroup("a").Info("msg", slog.Group("b", "foo", 1))
+
+	logger.WithGroup("a").WithGroup("b").WithGroup("c").Info("msg")               // want `groups should not be nested deeper than 2 levels`
+	logger.WithGroup("a").Info("msg", slog.Group("b", slog.Group("c", "foo", 1))) // want `groups should not be nested deeper than 2 levels`
+	l := logger.WithGroup("a").WithGroup("b")
+	l.Info("msg", slog.Group("c", "foo", 1))                                                               // want `groups should not be nested deeper than 2 levels`
+	slog.Info("msg", slog.Group("a", slog.Group("b", slog.Group("c", "foo", 1))))                          // want `groups should not be nested deeper than 2 levels`
+	slog.Info("msg", slog.GroupAttrs("a", slog.GroupAttrs("b", slog.GroupAttrs("c", slog.Int("foo", 1))))) // want `groups should not be nested deeper than 2 levels`
+}
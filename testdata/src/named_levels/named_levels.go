@@ -0,0 +1,27 @@
+package named_levels
+
+import (
+	"context"
+	"log/slog"
+)
+
+const (
+	LevelTrace  = slog.Level(-8)
+	LevelNotice = slog.Level(2)
+)
+
+func _(ctx context.Context, logger *slog.Logger, leveler slog.Leveler, levelVar *slog.LevelVar, level slog.Level) {
+	slog.Log(ctx, slog.LevelWarn, "msg")
+	slog.Log(ctx, LevelTrace, "msg")
+	slog.Log(ctx, LevelNotice, "msg")            // want `LevelNotice is not an allowed level constant, add "named_levels.LevelNotice" to the custom levels to allow it`
+	slog.Log(ctx, 4, "msg")                      // want `levels should be named constants, use slog.LevelWarn instead`
+	slog.Log(ctx, slog.Level(8), "msg")          // want `levels should be named constants, use slog.LevelError instead`
+	slog.Log(ctx, slog.LevelInfo+2, "msg")       // want `levels should be named constants`
+	slog.LogAttrs(ctx, slog.LevelDebug+4, "msg") // want `levels should be named constants, use slog.LevelInfo instead`
+	logger.Log(ctx, -4, "msg")                   // want `levels should be named constants, use slog.LevelDebug instead`
+	logger.LogAttrs(ctx, (slog.LevelError), "msg")
+	slog.Log(ctx, leveler.Level(), "msg")
+	slog.Log(ctx, levelVar.Level(), "msg")
+	slog.Log(ctx, level, "msg")   // want `dynamic levels should come from a slog.Leveler`
+	slog.Log(ctx, level+1, "msg") // want `dynamic levels should come from a slog.Leveler`
+}
@@ -0,0 +1,13 @@
+package no_empty_groups
+
+import "log/slog"
+
+func _(logger *slog.Logger, args []any, attrs []slog.Attr) {
+	slog.Info("msg", slog.Group("g", "foo", 1))
+	slog.Info("msg", slog.Group("g", args...))
+	slog.Info("msg", slog.GroupAttrs("g", attrs...))
+	logger.WithGroup("g").Info("msg")
+
+	slog.Info("msg", slog.Group("g"))      // want `empty groups are ignored by handlers and should not be used`
+	slog.Info("msg", slog.GroupAttrs("g")) // want `empty groups are ignored by handlers and should not be used`
+}
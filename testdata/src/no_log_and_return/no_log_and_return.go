@@ -0,0 +1,58 @@
+package no_log_and_return
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+func f() error { return nil }
+
+func _() error {
+	err := f()
+	if err != nil {
+		slog.Error("msg", "err", err) // want `the error should be either logged or returned, not both`
+		return err
+	}
+	if err != nil {
+		slog.Error("msg", slog.Any("err", err)) // want `the error should be either logged or returned, not both`
+		return fmt.Errorf("f: %w", err)
+	}
+	if err != nil {
+		slog.Error("msg", "err", err.Error()) // want `the error should be either logged or returned, not both`
+		return errors.Join(err, errors.New(""))
+	}
+	if err != nil {
+		slog.Error("msg", "err", err)
+		return nil
+	}
+	if err != nil {
+		slog.Error("msg", "err", err)
+		if true {
+			return err
+		}
+	}
+	if err := f(); err != nil {
+		slog.Error("msg")
+		return err
+	}
+	return nil
+}
+
+func _() (int, error) {
+	if err := f(); err != nil {
+		slog.Warn("msg", "err", err) // want `the error should be either logged or returned, not both`
+		return 0, err
+	}
+	return 0, nil
+}
+
+type Server struct{}
+
+func (s *Server) handle() error {
+	if err := f(); err != nil {
+		slog.Error("msg", "err", err)
+		return err
+	}
+	return nil
+}
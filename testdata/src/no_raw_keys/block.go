@@ -0,0 +1,14 @@
+package no_raw_keys
+
+import "log/slog"
+
+const (
+	requestKey = "request"
+	methodKey  = "method"
+)
+
+func _() {
+	slog.Info("msg", requestKey, 1)
+	slog.Info("msg", methodKey, "GET")
+	slog.Info("msg", "http-status", 200) // want `the "http-status" key should be a constant`
+}
@@ -1,3 +1,6 @@
 package keys
 
-const Foo = "foo"
+const (
+	Foo = "foo"
+	Bar = "bar"
+)
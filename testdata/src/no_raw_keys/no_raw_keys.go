@@ -24,4 +24,7 @@ func _() {
 	slog.Info("msg", slog.Attr{Key: "foo"})                          // want `the "foo" key should be a constant`
 	slog.Info("msg", slog.Attr{Key: "foo", Value: slog.IntValue(1)}) // want `the "foo" key should be a constant`
 	slog.Info("msg", slog.Attr{Value: slog.IntValue(1), Key: "foo"}) // want `the "foo" key should be a constant`
+	slog.Info("msg", "bar", 1)                                       // want `the "bar" key should be a constant`
+	slog.Info("msg", "user_id", 1)                                   // want `the "user_id" key should be a constant`
+	slog.Info("msg", slog.Int("user_id", 1))                         // want `the "user_id" key should be a constant`
 }
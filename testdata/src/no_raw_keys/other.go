@@ -0,0 +1,10 @@
+package no_raw_keys
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg", "user_id", 1)  // want `the "user_id" key should be a constant`
+	slog.Info("msg", "trace_id", 1) // want `the "trace_id" key should be a constant`
+	slog.Info("msg", "trace_id", 2) // want `the "trace_id" key should be a constant`
+	slog.Info("msg", "userId", 1)   // want `the "userId" key should be a constant`
+}
@@ -0,0 +1,20 @@
+package no_raw_keys
+
+import "log/slog"
+
+type keyKind string
+
+const (
+	kindName keyKind = "kind"
+	shadowKey        = "shadow"
+)
+
+func _() {
+	slog.Info("msg", "kind", 1) // want `the "kind" key should be a constant`
+
+	shadowKey := 1
+	slog.Info("msg", "shadow", shadowKey) // want `the "shadow" key should be a constant`
+
+	userIDKey := 2
+	slog.Info("msg", "user_id", userIDKey) // want `the "user_id" key should be a constant`
+}
@@ -0,0 +1,6 @@
+package keys
+
+const (
+	UID    = "uid"
+	UserID = "user_id"
+)
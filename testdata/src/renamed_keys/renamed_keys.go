@@ -0,0 +1,22 @@
+package renamed_keys
+
+import (
+	"log/slog"
+
+	"renamed_keys/keys"
+)
+
+const uidKey = "uid"
+
+func _() {
+	slog.Info("msg", "user_id", 1)
+	slog.Info("msg", keys.UserID, 1)
+	slog.Info("msg", slog.Int("user_id", 1))
+
+	slog.Info("msg", "uid", 1)              // want `the "uid" key is deprecated, use "user_id" instead`
+	slog.Info("msg", uidKey, 1)             // want `the "uid" key is deprecated, use "user_id" instead`
+	slog.Info("msg", keys.UID, 1)           // want `the "uid" key is deprecated, use "user_id" instead`
+	slog.Info("msg", slog.Int("uid", 1))    // want `the "uid" key is deprecated, use "user_id" instead`
+	slog.Info("msg", slog.Int(keys.UID, 1)) // want `the "uid" key is deprecated, use "user_id" instead`
+	slog.Info("msg", slog.Attr{Key: "uid"}) // want `the "uid" key is deprecated, use "user_id" instead`
+}
@@ -0,0 +1,8 @@
+package billing
+
+import "log/slog"
+
+func _() {
+	slog.Info("msg") // want `the log call should include the "tenant_id" key`
+	slog.Info("msg", "tenant_id", 1)
+}
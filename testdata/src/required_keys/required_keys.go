@@ -0,0 +1,59 @@
+package required_keys
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	_ "required_keys/billing"
+)
+
+const requestIDKey = "request_id"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	slog.Info("msg") // want `the log call should include the "request_id" key`
+	slog.Info("msg", "request_id", 1)
+	slog.Info("msg", requestIDKey, 1)
+	slog.Info("msg", slog.Int("request_id", 1))
+	slog.Info("msg", slog.Attr{Key: "request_id"})
+	slog.With("request_id", 1).Info("msg")
+	slog.Info("msg", slog.Group("http", "request_id", 1)) // want `the log call should include the "request_id" key`
+
+	logger := slog.With("request_id", 1)
+	logger.Info("msg")
+	child := logger.With("foo", 1)
+	child.Info("msg")
+
+	other := slog.Default()
+	other.Info("msg") // want `the log call should include the "request_id" key`
+	other = other.With("request_id", 1)
+	other.Info("msg")
+
+	func() {
+		slog.Info("msg") // want `the log call should include the "request_id" key`
+		logger.Info("msg")
+	}()
+
+	args := []any{"foo", 1}
+	slog.Info("msg", args...)
+}
+
+func notHandler() {
+	slog.Info("msg")
+	slog.Error("msg") // want `the log call should include the "error" key`
+	slog.ErrorContext(context.Background(), "msg", "error", errors.New(""))
+	slog.Log(context.Background(), slog.LevelError+1, "msg") // want `the log call should include the "error" key`
+	slog.Log(context.Background(), slog.LevelWarn, "msg")
+}
+
+type Server struct{}
+
+func (s *Server) HandleLogin(r *http.Request) {
+	slog.Error("msg") // want `the log call should include the "op", "request_id", "error" keys`
+	slog.Info("msg", "op", "login", "request_id", 1)
+}
+
+func (s *Server) Close() {
+	slog.Info("msg")
+}
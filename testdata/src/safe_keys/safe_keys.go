@@ -0,0 +1,20 @@
+package safe_keys
+
+import "log/slog"
+
+const spaceKey = "user id"
+
+func _() {
+	slog.Info("msg", "user_id", 1)
+	slog.Info("msg", "http.request-id", 1)
+	slog.Info("msg", "X_Request_ID", 1)
+
+	slog.Info("msg", "", 1)                     // want `the "" key is empty, which makes slog.TextHandler quote it`
+	slog.Info("msg", "1st_place", 1)            // want `the "1st_place" key starts with a digit, which may break log parsers`
+	slog.Info("msg", spaceKey, 1)               // want `the "user id" key contains a space, which makes slog.TextHandler quote it`
+	slog.Info("msg", "user=id", 1)              // want `the "user=id" key contains "=", which makes slog.TextHandler quote it`
+	slog.Info("msg", `"user_id"`, 1)            // want `the "\\"user_id\\"" key contains a quote, which makes both slog.TextHandler and slog.JSONHandler escape it`
+	slog.Info("msg", slog.Int("user\nid", 1))   // want `the "user\\nid" key contains a control character, which makes both slog.TextHandler and slog.JSONHandler escape it`
+	slog.Info("msg", slog.Int("café", 1))       // want `the "café" key contains a non-ASCII character, which may break log parsers`
+	slog.Info("msg", slog.Attr{Key: "user:id"}) // want `the "user:id" key contains ':', which may break log parsers`
+}
@@ -0,0 +1,15 @@
+package safe_keys_fix
+
+import "log/slog"
+
+const spaceKey = "user id"
+
+func _() {
+	slog.Info("msg", "user_id", 1)
+
+	slog.Info("msg", spaceKey, 1)              // want `the "user id" key contains a space` `keys should be written in snake_case`
+	slog.Info("msg", "user=id", 1)             // want `the "user=id" key contains "="`
+	slog.Info("msg", slog.Int("1st_place", 1)) // want `the "1st_place" key starts with a digit`
+	slog.Info("msg", slog.Int("user:id", 1))   // want `the "user:id" key contains ':'`
+	slog.Info("msg", "", 1)                    // want `the "" key is empty`
+}
@@ -0,0 +1,28 @@
+package sensitive_values
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+type config struct {
+	APIKey string
+	Port   int
+}
+
+func redact(s string) string { return "***" }
+
+func _(r *http.Request, password string, cfg config, userID int) {
+	slog.Info("msg", "pass", password)  // want `the value may hold sensitive data \(matches "password"\), it should be redacted`
+	slog.Info("msg", "key", cfg.APIKey) // want `the value may hold sensitive data \(matches "apiKey"\), it should be redacted`
+	slog.Info("msg", "port", cfg.Port)
+	slog.Info("msg", "headers", r.Header)                   // want `the value may hold sensitive data \(matches "r.Header"\), it should be redacted`
+	slog.Info("msg", "auth", r.Header.Get("Authorization")) // want `the value may hold sensitive data \(matches "r.Header"\), it should be redacted`
+	slog.Info("msg", slog.String("pass", password))         // want `the value may hold sensitive data \(matches "password"\), it should be redacted`
+	slog.Info("msg", "pass", redact(password))
+	slog.Info("msg", "user_id", userID)
+	slog.Info("msg", "msg", "password reset")
+	slog.Info("msg", "access_token", userID) // want `the "access_token" key may hold sensitive data, the value should be redacted`
+	slog.Info("msg", "secret", "")           // want `the "secret" key may hold sensitive data, the value should be redacted`
+	slog.Info("msg", "access_token", redact(""))
+}
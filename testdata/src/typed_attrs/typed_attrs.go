@@ -0,0 +1,39 @@
+package typed_attrs
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+type status int
+
+type event struct{ time.Time }
+
+func _(n int, n64 int64, u uint64, f float64, s string, b bool, t time.Time, d time.Duration, st status, err error, pt *time.Time, e event) {
+	slog.Info("msg",
+		slog.Any("n", n),   // want `use slog.Int instead`
+		slog.Any("n", 42),  // want `use slog.Int instead`
+		slog.Any("n", n64), // want `use slog.Int64 instead`
+		slog.Any("u", u),   // want `use slog.Uint64 instead`
+		slog.Any("f", f),   // want `use slog.Float64 instead`
+		slog.Any("s", s),   // want `use slog.String instead`
+		slog.Any("b", b),   // want `use slog.Bool instead`
+		slog.Any("t", t),   // want `use slog.Time instead`
+		slog.Any("d", d),   // want `use slog.Duration instead`
+		slog.Any("st", st),
+		slog.Any("err", err),
+		slog.Any("nil", nil),
+		slog.String("t", t.String()),                 // want `use slog.Time instead`
+		slog.String("d", d.String()),                 // want `use slog.Duration instead`
+		slog.String("n", strconv.Itoa(n)),            // want `use slog.Int instead`
+		slog.String("b", strconv.FormatBool(b)),      // want `use slog.Bool instead`
+		slog.String("n", strconv.FormatInt(n64, 10)), // want `use slog.Int64 instead`
+		slog.String("u", strconv.FormatUint(u, 10)),  // want `use slog.Uint64 instead`
+		slog.String("n", strconv.FormatInt(n64, 16)),
+		slog.String("s", s),
+		slog.String("t", pt.String()),
+		slog.String("t", e.String()),
+		slog.String("t", time.Time.String(t)),
+	)
+}
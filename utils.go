@@ -2,10 +2,19 @@ package sloglint
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
+	"log/slog"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 
+	"github.com/ettle/strcase"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
 	"golang.org/x/tools/go/types/typeutil"
 )
 
@@ -23,6 +32,34 @@ func funcName(info *types.Info, call *ast.CallExpr) string {
 	return ""
 }
 
+// shortFuncName returns the name of the called function as it's usually written, e.g. "slog.Info" or "slog.Logger.With".
+// If the function is unknown, an empty string is returned.
+func shortFuncName(info *types.Info, call *ast.CallExpr) string {
+	fn := typeutil.StaticCallee(info, call)
+	if fn == nil {
+		// Interface methods have no static callee.
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			fn, _ = info.Uses[sel.Sel].(*types.Func)
+		}
+	}
+	if fn == nil || fn.Pkg() == nil {
+		return ""
+	}
+
+	if recv := fn.Signature().Recv(); recv != nil {
+		typ := recv.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		if named, ok := typ.(*types.Named); ok {
+			return named.Obj().Pkg().Name() + "." + named.Obj().Name() + "." + fn.Name()
+		}
+		return fn.Name()
+	}
+
+	return fn.Pkg().Name() + "." + fn.Name()
+}
+
 func keyName(key ast.Expr) (string, bool) {
 	if ident, ok := key.(*ast.Ident); ok {
 		if ident.Obj == nil || ident.Obj.Decl == nil || ident.Obj.Kind != ast.Con {
@@ -46,6 +83,72 @@ func keyName(key ast.Expr) (string, bool) {
 	return name, true
 }
 
+// enclosingGroups returns the names of the slog.Group/GroupAttrs calls that enclose the cursor, outermost first,
+// including the groups opened with WithGroup on the logger of the enclosing log call.
+// The cursor itself is included, so it should point to the parent of a group's own key.
+// If the name of a group is not a constant, it is returned as an empty string.
+func enclosingGroups(info *types.Info, cursor inspector.Cursor) []string {
+	var groups []string
+	for cursor := range cursor.Enclosing(new(ast.CallExpr)) {
+		call := cursor.Node().(*ast.CallExpr)
+		if isGroup(info, call) && len(call.Args) > 0 {
+			name, _ := constKeyName(info, call.Args[0])
+			groups = append(groups, name)
+		}
+		// The groups opened on the logger the method is called on, e.g. logger.WithGroup("http").Info(...).
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && strings.HasPrefix(funcName(info, call), "(*log/slog.Logger).") {
+			withGroups := loggerGroups(info, outermostFunc(cursor), sel.X, call.Pos())
+			slices.Reverse(withGroups)
+			groups = append(groups, withGroups...)
+		}
+	}
+	slices.Reverse(groups)
+	return groups
+}
+
+// isStringLiteral reports whether the expression is a string literal, e.g. a raw key.
+func isStringLiteral(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+	return ok && lit.Kind == token.STRING
+}
+
+// constKeyName is like keyName, but it also resolves constants declared in other packages, e.g. keys.Foo.
+func constKeyName(info *types.Info, key ast.Expr) (string, bool) {
+	value := info.Types[key].Value
+	if value == nil || value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(value), true
+}
+
+// constantLiteral returns the string literal the constant is initialized with.
+// If the constant is declared in another package or is not initialized with a string literal, it returns nil.
+func constantLiteral(pass *analysis.Pass, c *types.Const) *ast.BasicLit {
+	file := fileOf(pass, c.Pos())
+	if file == nil {
+		return nil
+	}
+
+	var lit *ast.BasicLit
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok {
+			return lit == nil
+		}
+		for i, name := range spec.Names {
+			if pass.TypesInfo.Defs[name] != c || i >= len(spec.Values) {
+				continue
+			}
+			if l, ok := spec.Values[i].(*ast.BasicLit); ok && l.Kind == token.STRING {
+				lit = l
+			}
+		}
+		return false
+	})
+
+	return lit
+}
+
 func isGroup(info *types.Info, expr ast.Expr) bool {
 	call, ok := expr.(*ast.CallExpr)
 	if !ok {
@@ -54,3 +157,489 @@ func isGroup(info *types.Info, expr ast.Expr) bool {
 	name := funcName(info, call)
 	return name == "log/slog.Group" || name == "log/slog.GroupAttrs"
 }
+
+// fileOf returns the file that contains the given position.
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+	return nil
+}
+
+// keyConstant returns a reference to a constant with the given value, declared in one of the key packages.
+// Only the packages imported by the file that contains the given position are considered.
+func keyConstant(pass *analysis.Pass, pos token.Pos, keyPkgs []string, value string) (string, bool) {
+	file := fileOf(pass, pos)
+	if file == nil {
+		return "", false
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			panic("unreachable") // Import paths are always quoted.
+		}
+		if !slices.Contains(keyPkgs, path) {
+			continue
+		}
+
+		pkg := importedPackage(pass.Pkg, path)
+		if pkg == nil {
+			continue
+		}
+
+		pkgName := pkg.Name()
+		if spec.Name != nil {
+			pkgName = spec.Name.Name
+		}
+		if pkgName == "_" || pkgName == "." {
+			continue
+		}
+
+		if name, ok := stringConstant(pkg.Scope(), value, true); ok {
+			return pkgName + "." + name, true
+		}
+	}
+
+	return "", false
+}
+
+func importedPackage(pkg *types.Package, path string) *types.Package {
+	for _, imp := range pkg.Imports() {
+		if imp.Path() == path {
+			return imp
+		}
+	}
+	return nil
+}
+
+// stringConstant returns the name of the first (in alphabetical order) string constant with the given value in the scope.
+// Only untyped and plain string constants are considered: constants of named types (e.g. type Key string) may
+// mean something else and can't be passed to functions like slog.String.
+func stringConstant(scope *types.Scope, value string, exportedOnly bool) (string, bool) {
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || (exportedOnly && !c.Exported()) || c.Val().Kind() != constant.String {
+			continue
+		}
+		if basic, ok := c.Type().(*types.Basic); !ok || (basic.Kind() != types.String && basic.Kind() != types.UntypedString) {
+			continue
+		}
+		if constant.StringVal(c.Val()) == value {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// visibleAt reports whether the package-level name refers to the same object at the given position,
+// i.e. it's not shadowed by a local declaration. If the package doesn't declare the name, it must not be declared locally either.
+func visibleAt(pass *analysis.Pass, name string, pos token.Pos) bool {
+	scope := pass.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		return false
+	}
+	_, obj := scope.LookupParent(name, pos)
+	return obj == pass.Pkg.Scope().Lookup(name)
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	curr := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := range s {
+		curr[0] = i + 1
+		for j := range t {
+			cost := 1
+			if s[i] == t[j] {
+				cost = 0
+			}
+			curr[j+1] = min(prev[j+1]+1, curr[j]+1, prev[j]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(t)]
+}
+
+var regexps sync.Map // map[string]*regexp.Regexp
+
+// cachedRegexp compiles the regular expression once and then returns it from the cache.
+// The expression must be checked in Options.validate beforehand.
+func cachedRegexp(expr string) *regexp.Regexp {
+	if re, ok := regexps.Load(expr); ok {
+		return re.(*regexp.Regexp)
+	}
+	re, _ := regexps.LoadOrStore(expr, regexp.MustCompile(expr))
+	return re.(*regexp.Regexp)
+}
+
+// isKeyPattern reports whether the allowed/forbidden key is a pattern rather than an exact key.
+// Patterns starting with "^" are regular expressions, patterns containing "*" or "?" are globs,
+// and "@" followed by the name of a bundled preset refers to its keys, e.g. "@otel".
+func isKeyPattern(pattern string) bool {
+	_, isPreset := keyPresetRef(pattern)
+	return strings.HasPrefix(pattern, "^") || isPreset || strings.ContainsAny(pattern, "*?")
+}
+
+// keyPresetRef returns the name of the key preset the pattern refers to, e.g. "otel" for "@otel".
+// Since real keys may start with "@" too (e.g. "@timestamp"), only the names of the bundled presets are references.
+func keyPresetRef(pattern string) (string, bool) {
+	name, ok := strings.CutPrefix(pattern, "@")
+	if _, known := keyPresetTitles[name]; !ok || !known {
+		return "", false
+	}
+	return name, true
+}
+
+// expandKeyPresets replaces the references to key presets with the keys themselves.
+func expandKeyPresets(patterns []string) []string {
+	var expanded []string
+	for _, pattern := range patterns {
+		if name, ok := keyPresetRef(pattern); ok {
+			expanded = append(expanded, loadKeyPresets()[name].keys...)
+			continue
+		}
+		expanded = append(expanded, pattern)
+	}
+	return expanded
+}
+
+// keyPatternRegexp converts the pattern into a regular expression, see [isKeyPattern].
+func keyPatternRegexp(pattern string) string {
+	if strings.HasPrefix(pattern, "^") {
+		return pattern
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+
+	return sb.String()
+}
+
+// matchKeys reports whether the key matches any of the keys or patterns.
+func matchKeys(patterns []string, key string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		if !isKeyPattern(pattern) {
+			return pattern == key
+		}
+		if name, ok := keyPresetRef(pattern); ok {
+			return slices.Contains(loadKeyPresets()[name].keys, key)
+		}
+		return cachedRegexp(keyPatternRegexp(pattern)).MatchString(key)
+	})
+}
+
+// logLevel returns the level of the log call.
+// For Log/LogAttrs, the level argument must be a constant.
+// For other functions, including custom ones, the level is derived from the function name, e.g. "Errorf" is "error".
+func logLevel(info *types.Info, call *ast.CallExpr) (slog.Level, bool) {
+	fn := typeutil.StaticCallee(info, call)
+	if fn == nil {
+		return 0, false
+	}
+
+	switch fn.FullName() {
+	case "log/slog.Log", "log/slog.LogAttrs", "(*log/slog.Logger).Log", "(*log/slog.Logger).LogAttrs":
+		if len(call.Args) < 2 {
+			return 0, false
+		}
+		value := info.Types[call.Args[1]].Value
+		if value == nil || value.Kind() != constant.Int {
+			return 0, false
+		}
+		level, ok := constant.Int64Val(value)
+		return slog.Level(level), ok
+	}
+
+	// Only whole words are matched, so that e.g. "ErrorInfo" is "error" and "Information" has no level.
+	// The first word that names a level wins, optionally followed by "f" for printf-like functions.
+	for _, word := range strings.Split(strcase.ToSnake(fn.Name()), "_") {
+		switch strings.TrimSuffix(word, "f") {
+		case levelDebug:
+			return slog.LevelDebug, true
+		case levelInfo:
+			return slog.LevelInfo, true
+		case levelWarn, "warning":
+			return slog.LevelWarn, true
+		case levelError:
+			return slog.LevelError, true
+		}
+	}
+
+	return 0, false
+}
+
+// levelName returns the name of the level range the level belongs to, e.g. "warn" for slog.LevelWarn+2.
+func levelName(level slog.Level) string {
+	switch {
+	case level < slog.LevelInfo:
+		return levelDebug
+	case level < slog.LevelWarn:
+		return levelInfo
+	case level < slog.LevelError:
+		return levelWarn
+	default:
+		return levelError
+	}
+}
+
+// argumentKeys returns the constant keys of the top-level arguments, both key-value pairs and attributes.
+func argumentKeys(info *types.Info, args []ast.Expr) []string {
+	var keys []string
+	for i := 0; i < len(args); i++ {
+		var key ast.Expr
+		switch arg := args[i].(type) {
+		case *ast.CallExpr:
+			if name := funcName(info, arg); strings.HasPrefix(name, "log/slog.") && len(arg.Args) > 0 && typeName(info, arg) == "log/slog.Attr" {
+				key = arg.Args[0] // slog.Int(key, ...), slog.Group(key, ...), etc.
+			}
+		case *ast.CompositeLit:
+			if typeName(info, arg) == "log/slog.Attr" {
+				key = attrLiteralKey(arg)
+			}
+		}
+		if key == nil && typeName(info, args[i]) == "string" {
+			key = args[i]
+			i++ // Skip the value.
+		}
+		if key == nil {
+			continue
+		}
+		if name, ok := constKeyName(info, key); ok {
+			keys = append(keys, name)
+		}
+	}
+	return keys
+}
+
+// attrLiteralKey returns the key of the slog.Attr composite literal, or nil if there is none.
+func attrLiteralKey(attr *ast.CompositeLit) ast.Expr {
+	for i, elt := range attr.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			if i == 0 && len(attr.Elts) == 2 {
+				return elt // slog.Attr{..., ...}
+			}
+			continue
+		}
+		if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == "Key" {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+// sourceText returns the source code between the given positions.
+func sourceText(pass *analysis.Pass, pos, end token.Pos) ([]byte, bool) {
+	file := pass.Fset.File(pos)
+	if file == nil {
+		return nil, false
+	}
+	content, err := pass.ReadFile(file.Name())
+	if err != nil {
+		return nil, false
+	}
+	start, stop := file.Offset(pos), file.Offset(end)
+	if start > stop || stop > len(content) {
+		return nil, false
+	}
+	return content[start:stop], true
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// isError reports whether the expression's type implements the error interface.
+func isError(info *types.Info, expr ast.Expr) bool {
+	typ := info.TypeOf(expr)
+	if typ == nil || types.Identical(typ, types.Typ[types.UntypedNil]) {
+		return false
+	}
+	return types.Implements(typ, errorType)
+}
+
+// errorString returns the error if the expression is an err.Error() call.
+func errorString(info *types.Info, expr ast.Expr) (ast.Expr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) > 0 {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Error" || !isError(info, sel.X) {
+		return nil, false
+	}
+	return sel.X, true
+}
+
+// enclosingErrorCheck returns the error variable of the innermost error-handling block that encloses the cursor,
+// within the same function. The blocks to consider are given as the possible values of [Options.ErrorBlocks].
+func enclosingErrorCheck(info *types.Info, cursor inspector.Cursor, blocks []string) (types.Object, bool) {
+	pos := cursor.Node().Pos()
+	within := func(node ast.Node) bool {
+		return node.Pos() <= pos && pos < node.End()
+	}
+
+	for cursor := range cursor.Enclosing(new(ast.IfStmt), new(ast.CaseClause), new(ast.FuncDecl), new(ast.FuncLit)) {
+		switch node := cursor.Node().(type) {
+		case *ast.IfStmt:
+			if within(node.Body) && slices.Contains(blocks, errorBlockIf) {
+				if obj, ok := errorCheck(info, node.Cond, token.NEQ); ok {
+					return obj, true
+				}
+			}
+			if node.Else != nil && within(node.Else) && slices.Contains(blocks, errorBlockElse) {
+				if obj, ok := errorCheck(info, node.Cond, token.EQL); ok {
+					return obj, true
+				}
+			}
+		case *ast.CaseClause:
+			if pos < node.Colon || !slices.Contains(blocks, errorBlockCase) {
+				continue
+			}
+			if stmt, ok := cursor.Parent().Parent().Node().(*ast.SwitchStmt); !ok || stmt.Tag != nil {
+				continue
+			}
+			for _, expr := range node.List {
+				if obj, ok := errorCheck(info, expr, token.NEQ); ok {
+					return obj, true
+				}
+			}
+		default:
+			return nil, false // Don't cross function boundaries.
+		}
+	}
+
+	return nil, false
+}
+
+// errorCheck returns the error variable if the condition is "err != nil" or "err == nil", depending on the operator.
+func errorCheck(info *types.Info, cond ast.Expr, op token.Token) (types.Object, bool) {
+	bin, ok := ast.Unparen(cond).(*ast.BinaryExpr)
+	if !ok || bin.Op != op {
+		return nil, false
+	}
+	x, y := ast.Unparen(bin.X), ast.Unparen(bin.Y)
+	if ident, ok := y.(*ast.Ident); !ok || ident.Name != "nil" {
+		return nil, false
+	}
+	ident, ok := x.(*ast.Ident)
+	if !ok || !isError(info, ident) {
+		return nil, false
+	}
+	obj := info.ObjectOf(ident)
+	return obj, obj != nil
+}
+
+// usesObject reports whether the expression refers to the object.
+func usesObject(info *types.Info, expr ast.Expr, obj types.Object) bool {
+	var found bool
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if ident, ok := node.(*ast.Ident); ok && info.Uses[ident] == obj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// containsError reports whether the expression is or contains a value of the error type, e.g. slog.Any("err", err).
+func containsError(info *types.Info, expr ast.Expr) bool {
+	var found bool
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if e, ok := node.(ast.Expr); ok && isError(info, e) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// packageSetting returns the setting for the package from a map keyed by package patterns.
+// If several patterns match the package, the most specific (i.e. the longest) one wins.
+func packageSetting[V any](pkgPath string, settings map[string]V) (V, bool) {
+	var match string
+	for pattern := range settings {
+		if matchPackage(pattern, pkgPath) && len(pattern) > len(match) {
+			match = pattern
+		}
+	}
+	if match == "" {
+		var zero V
+		return zero, false
+	}
+	return settings[match], true
+}
+
+// matchPackage reports whether the package path matches the pattern.
+// Like in the go command, a pattern ending with "/..." matches the package and all its subpackages.
+func matchPackage(pattern, pkgPath string) bool {
+	if base, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return pkgPath == base || strings.HasPrefix(pkgPath, base+"/")
+	}
+	return pkgPath == pattern
+}
+
+// importName returns the name the package is imported with in the file that contains the given position.
+// Blank and dot imports are not considered.
+func importName(pass *analysis.Pass, pos token.Pos, path string) (string, bool) {
+	file := fileOf(pass, pos)
+	if file == nil {
+		return "", false
+	}
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err != nil || p != path {
+			continue
+		}
+		if spec.Name == nil {
+			if pkg := importedPackage(pass.Pkg, path); pkg != nil {
+				return pkg.Name(), true
+			}
+			continue
+		}
+		if spec.Name.Name != "_" && spec.Name.Name != "." {
+			return spec.Name.Name, true
+		}
+	}
+	return "", false
+}
+
+// isLogValuer reports whether the type implements [slog.LogValuer].
+// Methods with a pointer receiver are not considered, since slog doesn't take the address of values.
+func isLogValuer(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(typ, false, nil, "LogValue")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+	sig := fn.Signature()
+	return sig.Params().Len() == 0 && sig.Results().Len() == 1 && sig.Results().At(0).Type().String() == "log/slog.Value"
+}
+
+// relativeTypeName returns the type name qualified with the package name, unless the type is declared in the current package.
+func relativeTypeName(pass *analysis.Pass, typ types.Type) string {
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		if pkg == pass.Pkg {
+			return ""
+		}
+		return pkg.Name()
+	})
+}
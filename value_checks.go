@@ -0,0 +1,183 @@
+package sloglint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+func logValuerReceiver(pass *analysis.Pass, value ast.Expr) {
+	typ := pass.TypesInfo.TypeOf(value)
+	if typ == nil || isLogValuer(typ) {
+		return
+	}
+	if _, ok := typ.(*types.Named); !ok {
+		return
+	}
+	if _, ok := typ.Underlying().(*types.Interface); ok {
+		return
+	}
+	if !isLogValuer(types.NewPointer(typ)) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     value.Pos(),
+		End:     value.End(),
+		Message: fmt.Sprintf("the LogValue method of %s has a pointer receiver and is not called for values, use a pointer instead", relativeTypeName(pass, typ)),
+	}
+	switch value := ast.Unparen(value).(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.CompositeLit:
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			TextEdits: []analysis.TextEdit{{Pos: value.Pos(), End: value.Pos(), NewText: []byte("&")}},
+		}}
+	}
+	pass.Report(diag)
+}
+
+func requiredLogValuer(pass *analysis.Pass, value ast.Expr, patterns []string, sensitive bool) {
+	typ := pass.TypesInfo.TypeOf(value)
+	if typ == nil || isLogValuer(typ) {
+		return
+	}
+
+	named := loggedNamedType(typ)
+	if named == nil || isLogValuer(named) {
+		return
+	}
+	name := relativeTypeName(pass, named)
+
+	if obj := named.Obj(); obj.Pkg() != nil && slices.ContainsFunc(patterns, func(pattern string) bool {
+		return cachedRegexp(keyPatternRegexp(pattern)).MatchString(obj.Pkg().Path() + "." + obj.Name())
+	}) {
+		pass.ReportRangef(value, "the %s type should implement slog.LogValuer to be logged", name)
+		return
+	}
+
+	if sensitive {
+		if field, ok := sensitiveField(named); ok {
+			pass.ReportRangef(value, "the %s type has the sensitive %s field and should implement slog.LogValuer to be logged", name, field)
+		}
+	}
+}
+
+// loggedNamedType returns the named type of the logged value, looking through pointers, slices, arrays, and maps.
+func loggedNamedType(typ types.Type) *types.Named {
+	for {
+		switch t := types.Unalias(typ).(type) {
+		case *types.Named:
+			return t
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		case *types.Array:
+			typ = t.Elem()
+		case *types.Map:
+			typ = t.Elem()
+		default:
+			return nil
+		}
+	}
+}
+
+// sensitiveFieldNames are the substrings of field names that hold sensitive data, in lower case.
+var sensitiveFieldNames = []string{"password", "passwd", "secret", "token", "apikey", "api_key", "credential", "privatekey", "private_key"}
+
+// sensitiveField returns the name of the first field of the struct type that is either named like a sensitive one
+// (e.g. "Password" or "AccessToken") or tagged with `log:"-"`. The fields of embedded structs are checked too.
+func sensitiveField(named *types.Named, seen ...*types.Named) (string, bool) {
+	seen = append(seen, named)
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return "", false
+	}
+	for i := range st.NumFields() {
+		field := st.Field(i)
+		if reflect.StructTag(st.Tag(i)).Get("log") == "-" {
+			return field.Name(), true
+		}
+		lower := strings.ToLower(field.Name())
+		if !field.Embedded() && slices.ContainsFunc(sensitiveFieldNames, func(name string) bool { return strings.Contains(lower, name) }) {
+			return field.Name(), true
+		}
+		if field.Embedded() {
+			if embedded := loggedNamedType(field.Type()); embedded != nil && !slices.Contains(seen, embedded) {
+				if name, ok := sensitiveField(embedded, seen...); ok {
+					return name, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+func sensitiveValues(pass *analysis.Pass, key, value ast.Expr, patterns, keys, redactionFuncs []string) {
+	if call, ok := ast.Unparen(value).(*ast.CallExpr); ok && slices.Contains(redactionFuncs, funcName(pass.TypesInfo, call)) {
+		return
+	}
+
+	if name, ok := constKeyName(pass.TypesInfo, key); ok && matchKeys(keys, name) {
+		pass.ReportRangef(value, "the %q key may hold sensitive data, the value should be redacted", name)
+		return
+	}
+
+	var match string
+	ast.Inspect(value, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			if slices.Contains(redactionFuncs, funcName(pass.TypesInfo, node)) {
+				return false
+			}
+		case *ast.Ident, *ast.SelectorExpr:
+			expr := strings.ToLower(types.ExprString(node.(ast.Expr)))
+			for _, pattern := range patterns {
+				if strings.Contains(expr, strings.ToLower(pattern)) {
+					match = pattern
+					return false
+				}
+			}
+		}
+		return match == ""
+	})
+	if match != "" {
+		pass.ReportRangef(value, "the value may hold sensitive data (matches %q), it should be redacted", match)
+	}
+}
+
+func forbiddenValueTypes(pass *analysis.Pass, value ast.Expr, forbidden map[string]string) {
+	typ := pass.TypesInfo.TypeOf(value)
+	if typ == nil {
+		return
+	}
+
+	name := types.TypeString(typ, nil)
+	candidates := []string{name}
+	switch typ.Underlying().(type) {
+	case *types.Chan:
+		candidates = append(candidates, "chan")
+	case *types.Signature:
+		candidates = append(candidates, "func")
+	}
+	if name == "[]uint8" {
+		candidates = append(candidates, "[]byte") // byte is an alias for uint8.
+	}
+
+	for _, candidate := range candidates {
+		suggestion, ok := forbidden[candidate]
+		if !ok {
+			continue
+		}
+		if suggestion != "" {
+			pass.ReportRangef(value, "values of the %s type should not be logged, log %s instead", candidate, suggestion)
+		} else {
+			pass.ReportRangef(value, "values of the %s type should not be logged", candidate)
+		}
+		return
+	}
+}